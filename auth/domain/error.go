@@ -0,0 +1,16 @@
+package domain
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrNoCredentials   = Error("no credentials")
+	ErrInvalidToken    = Error("invalid token")
+	ErrExpiredToken    = Error("expired token")
+	ErrUnauthenticated = Error("unauthenticated")
+	ErrForbidden       = Error("forbidden")
+	ErrInvalidRole     = Error("invalid role")
+)
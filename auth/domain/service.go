@@ -15,14 +15,15 @@ type Service interface {
 }
 
 type AuthenticateRequest struct {
-	Cookies string
+	Cookies       string
+	Authorization string
 }
 
 func (r AuthenticateRequest) Validate() error {
 	var err zeroerror.Error
 
-	if r.Cookies == "" {
-		err.Add(fmt.Errorf("empty cookies"))
+	if r.Cookies == "" && r.Authorization == "" {
+		err.Add(ErrNoCredentials)
 	}
 
 	return err.Err()
@@ -36,7 +37,7 @@ func (r AuthenticateResponse) Validate() error {
 	var err zeroerror.Error
 
 	if ve := r.User.Validate(); ve != nil {
-		err.Add(fmt.Errorf("invalid user: %w", err))
+		err.Add(fmt.Errorf("invalid user: %w", ve))
 	}
 
 	return err.Err()
@@ -47,10 +48,23 @@ type User struct {
 	SessionID string
 	Username  string
 	Email     string
+	Roles     Roles
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// HasRole reports whether the user was granted the role, directly or
+// through a role ranked above it.
+func (u User) HasRole(role Role) bool {
+	for _, r := range u.Roles {
+		if r.Includes(role) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (u User) Validate() error {
 	var err zeroerror.Error
 
@@ -70,6 +84,10 @@ func (u User) Validate() error {
 		err.Add(fmt.Errorf("empty email"))
 	}
 
+	if ve := u.Roles.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid roles: %w", ve))
+	}
+
 	if u.CreatedAt.IsZero() {
 		err.Add(fmt.Errorf("zero created at"))
 	}
@@ -80,3 +98,61 @@ func (u User) Validate() error {
 
 	return err.Err()
 }
+
+type Roles []Role
+
+func (r Roles) Validate() error {
+	var err zeroerror.Error
+
+	for i, role := range r {
+		if ve := role.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at index %d: %w", ErrInvalidRole, i, ve))
+		}
+	}
+
+	return err.Err()
+}
+
+// Role describes what a user may do. Admins can do everything editors can,
+// editors can do everything viewers can.
+type Role string
+
+func (r Role) Validate() error {
+	switch r {
+	case RoleAdmin, RoleEditor, RoleViewer:
+		return nil
+	}
+
+	return fmt.Errorf("role %q is not supported", r)
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// Includes reports whether r grants at least the permissions of other.
+func (r Role) Includes(other Role) bool {
+	rank, ok := roleRanks[r]
+	if !ok {
+		return false
+	}
+
+	otherRank, ok := roleRanks[other]
+	if !ok {
+		return false
+	}
+
+	return rank >= otherRank
+}
+
+const (
+	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
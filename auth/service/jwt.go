@@ -0,0 +1,120 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vediagames/platform/auth/domain"
+)
+
+type header struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+}
+
+type claims struct {
+	Subject   string   `json:"sub"`
+	SessionID string   `json:"sid"`
+	Username  string   `json:"username"`
+	Email     string   `json:"email"`
+	Roles     []string `json:"roles"`
+	CreatedAt int64    `json:"created_at"`
+	UpdatedAt int64    `json:"updated_at"`
+	IssuedAt  int64    `json:"iat"`
+	NotBefore int64    `json:"nbf"`
+	ExpiresAt int64    `json:"exp"`
+}
+
+func (c claims) user() domain.User {
+	createdAt := c.CreatedAt
+	if createdAt == 0 {
+		createdAt = c.IssuedAt
+	}
+
+	updatedAt := c.UpdatedAt
+	if updatedAt == 0 {
+		updatedAt = createdAt
+	}
+
+	roles := make(domain.Roles, 0, len(c.Roles))
+	for _, role := range c.Roles {
+		roles = append(roles, domain.Role(role))
+	}
+
+	return domain.User{
+		ID:        c.Subject,
+		SessionID: c.SessionID,
+		Username:  c.Username,
+		Email:     c.Email,
+		Roles:     roles,
+		CreatedAt: time.Unix(createdAt, 0).UTC(),
+		UpdatedAt: time.Unix(updatedAt, 0).UTC(),
+	}
+}
+
+// parseToken verifies a compact HS256 JWT and returns its claims.
+// Tokens without an expiration are rejected.
+func parseToken(token string, key []byte, now time.Time) (claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims{}, fmt.Errorf("%w: expected 3 parts, got %d", domain.ErrInvalidToken, len(parts))
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return claims{}, fmt.Errorf("%w: failed to decode header: %w", domain.ErrInvalidToken, err)
+	}
+
+	if h.Algorithm != "HS256" {
+		return claims{}, fmt.Errorf("%w: unsupported algorithm %q", domain.ErrInvalidToken, h.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return claims{}, fmt.Errorf("%w: failed to decode signature: %w", domain.ErrInvalidToken, err)
+	}
+
+	if !hmac.Equal(signature, sign(parts[0]+"."+parts[1], key)) {
+		return claims{}, fmt.Errorf("%w: signature mismatch", domain.ErrInvalidToken)
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return claims{}, fmt.Errorf("%w: failed to decode claims: %w", domain.ErrInvalidToken, err)
+	}
+
+	switch {
+	case c.ExpiresAt == 0:
+		return claims{}, fmt.Errorf("%w: missing expiration", domain.ErrInvalidToken)
+	case now.Unix() >= c.ExpiresAt:
+		return claims{}, domain.ErrExpiredToken
+	case c.NotBefore != 0 && now.Unix() < c.NotBefore:
+		return claims{}, fmt.Errorf("%w: not valid yet", domain.ErrInvalidToken)
+	}
+
+	return c, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("failed to decode base64: %w", err)
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+func sign(signingInput string, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vediagames/platform/auth/domain"
+)
+
+func Test_parseToken(t *testing.T) {
+	key := []byte("secret")
+	now := time.Unix(1_700_000_000, 0)
+
+	valid := claims{
+		Subject:   "1",
+		SessionID: "session",
+		Username:  "editor",
+		Email:     "editor@vediagames.com",
+		Roles:     []string{"editor"},
+		IssuedAt:  now.Add(-time.Minute).Unix(),
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	}
+
+	expired := valid
+	expired.ExpiresAt = now.Add(-time.Second).Unix()
+
+	noExpiration := valid
+	noExpiration.ExpiresAt = 0
+
+	notYetValid := valid
+	notYetValid.NotBefore = now.Add(time.Minute).Unix()
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "valid",
+			token: testToken(t, "HS256", valid, key),
+		},
+		{
+			name:    "expired",
+			token:   testToken(t, "HS256", expired, key),
+			wantErr: domain.ErrExpiredToken,
+		},
+		{
+			name:    "no expiration",
+			token:   testToken(t, "HS256", noExpiration, key),
+			wantErr: domain.ErrInvalidToken,
+		},
+		{
+			name:    "not yet valid",
+			token:   testToken(t, "HS256", notYetValid, key),
+			wantErr: domain.ErrInvalidToken,
+		},
+		{
+			name:    "wrong key",
+			token:   testToken(t, "HS256", valid, []byte("other")),
+			wantErr: domain.ErrInvalidToken,
+		},
+		{
+			name:    "unsupported algorithm",
+			token:   testToken(t, "none", valid, key),
+			wantErr: domain.ErrInvalidToken,
+		},
+		{
+			name:    "malformed",
+			token:   "abc.def",
+			wantErr: domain.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseToken(tt.token, key, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want error %v, got %v", tt.wantErr, err)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if ve := got.user().Validate(); ve != nil {
+				t.Fatalf("invalid user: %s", ve)
+			}
+		})
+	}
+}
+
+func testToken(t *testing.T, alg string, c claims, key []byte) string {
+	t.Helper()
+
+	h, err := json.Marshal(header{Algorithm: alg, Type: "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(p)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sign(signingInput, key))
+}
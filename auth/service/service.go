@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/auth/domain"
+)
+
+type Config struct {
+	// Key is the HMAC key used to verify HS256 signed tokens.
+	Key string
+	// CookieName is the name of the cookie carrying the session token.
+	CookieName string
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Key == "", fmt.Errorf("empty key"))
+	err.AddIf(c.CookieName == "", fmt.Errorf("empty cookie name"))
+
+	return err.Err()
+}
+
+type service struct {
+	key        []byte
+	cookieName string
+}
+
+func New(cfg Config) domain.Service {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &service{
+		key:        []byte(cfg.Key),
+		cookieName: cfg.CookieName,
+	}
+}
+
+type contextKey string
+
+const userContextKey contextKey = "auth_user_context_key"
+
+func (s service) Authenticate(_ context.Context, req domain.AuthenticateRequest) (domain.AuthenticateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.AuthenticateResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	token, err := s.token(req)
+	if err != nil {
+		return domain.AuthenticateResponse{}, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	c, err := parseToken(token, s.key, time.Now())
+	if err != nil {
+		return domain.AuthenticateResponse{}, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	res := domain.AuthenticateResponse{
+		User: c.user(),
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.AuthenticateResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+// token returns the bearer token when present, otherwise the session cookie.
+func (s service) token(req domain.AuthenticateRequest) (string, error) {
+	if req.Authorization != "" {
+		scheme, token, ok := strings.Cut(req.Authorization, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			return "", fmt.Errorf("%w: malformed authorization header", domain.ErrInvalidToken)
+		}
+
+		return token, nil
+	}
+
+	r := http.Request{
+		Header: http.Header{
+			"Cookie": []string{req.Cookies},
+		},
+	}
+
+	cookie, err := r.Cookie(s.cookieName)
+	if err != nil {
+		return "", domain.ErrNoCredentials
+	}
+
+	if cookie.Value == "" {
+		return "", domain.ErrNoCredentials
+	}
+
+	return cookie.Value, nil
+}
+
+func (s service) ToContext(ctx context.Context, user domain.User) context.Context {
+	return context.WithValue(ctx, userContextKey, user)
+}
+
+func (s service) FromContext(ctx context.Context) (domain.User, error) {
+	user, ok := ctx.Value(userContextKey).(domain.User)
+	if !ok {
+		return domain.User{}, domain.ErrUnauthenticated
+	}
+
+	return user, nil
+}
@@ -7,4 +7,7 @@ import (
 
 type Client interface {
 	Upload(ctx context.Context, path string, reader io.Reader) error
+	// DeletePrefix deletes every object whose path starts with prefix and
+	// returns how many were deleted.
+	DeletePrefix(ctx context.Context, prefix string) (int, error)
 }
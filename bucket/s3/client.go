@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"github.com/vediagames/platform/bucket/domain"
 )
@@ -116,3 +117,55 @@ func (s client) Upload(ctx context.Context, path string, reader io.Reader) error
 
 	return nil
 }
+
+func (s client) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	if prefix == "" {
+		return 0, fmt.Errorf("empty prefix")
+	}
+
+	deleted := 0
+
+	// A listed page holds at most 1000 keys, the most DeleteObjects takes.
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to list: %w", err)
+		}
+
+		if len(page.Contents) == 0 {
+			continue
+		}
+
+		objects := make([]types.ObjectIdentifier, 0, len(page.Contents))
+		for _, o := range page.Contents {
+			objects = append(objects, types.ObjectIdentifier{
+				Key: o.Key,
+			})
+		}
+
+		res, err := s.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s.bucket),
+			Delete: &types.Delete{
+				Objects: objects,
+				Quiet:   true,
+			},
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete: %w", err)
+		}
+
+		deleted += len(objects) - len(res.Errors)
+
+		if len(res.Errors) > 0 {
+			return deleted, fmt.Errorf("failed to delete %d objects, first %q: %s",
+				len(res.Errors), aws.ToString(res.Errors[0].Key), aws.ToString(res.Errors[0].Message))
+		}
+	}
+
+	return deleted, nil
+}
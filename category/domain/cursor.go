@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Cursor points at a category of a listing, the page after it starts with the
+// category that follows. Categories are listed by ID when paging by cursor.
+// Clients get it as an opaque string.
+type Cursor struct {
+	ID int `json:"i"`
+}
+
+func (c Cursor) IsZero() bool {
+	return c.ID == 0
+}
+
+func (c Cursor) Validate() error {
+	if c.ID < 1 {
+		return ErrInvalidID
+	}
+
+	return nil
+}
+
+func (c Cursor) String() string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal cursor: %w", err))
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func ParseCursor(s string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: failed to decode: %w", ErrInvalidCursor, err)
+	}
+
+	var c Cursor
+
+	if err := json.Unmarshal(b, &c); err != nil {
+		return Cursor{}, fmt.Errorf("%w: failed to unmarshal: %w", ErrInvalidCursor, err)
+	}
+
+	if ve := c.Validate(); ve != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", ErrInvalidCursor, ve)
+	}
+
+	return c, nil
+}
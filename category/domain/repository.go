@@ -8,6 +8,10 @@ type Repository interface {
 	Find(context.Context, FindQuery) (FindResult, error)
 	FindOne(context.Context, FindOneQuery) (FindOneResult, error)
 	IncreaseField(context.Context, IncreaseFieldQuery) error
+	Insert(context.Context, InsertQuery) (InsertResult, error)
+	Update(context.Context, UpdateQuery) (UpdateResult, error)
+	Delete(context.Context, DeleteQuery) (DeleteResult, error)
+	FindSlugRedirect(context.Context, FindSlugRedirectQuery) (FindSlugRedirectResult, error)
 }
 
 type FindOneQuery struct {
@@ -33,8 +37,51 @@ type FindQuery struct {
 	AllowDeleted   bool
 	AllowInvisible bool
 	IDRefs         IDs
+	// First is set instead of Page and Limit to page by cursor.
+	First int
+	After Cursor
 }
 
 type FindResult struct {
-	Data Categories
+	Data        Categories
+	Cursors     []Cursor
+	HasNextPage bool
+}
+
+type InsertQuery struct {
+	Slug   string
+	Status Status
+	Texts  map[Language]Texts
+}
+
+type InsertResult struct {
+	Data Category
+}
+
+type UpdateQuery struct {
+	ID     int
+	Slug   string
+	Status Status
+	Texts  map[Language]Texts
+}
+
+type UpdateResult struct {
+	Data Category
+}
+
+type DeleteQuery struct {
+	ID   int
+	Slug string
+}
+
+type DeleteResult struct {
+}
+
+// FindSlugRedirectQuery finds the category that had Slug before it was renamed.
+type FindSlugRedirectQuery struct {
+	Slug string
+}
+
+type FindSlugRedirectResult struct {
+	ID int
 }
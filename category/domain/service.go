@@ -11,6 +11,9 @@ type Service interface {
 	List(context.Context, ListRequest) (ListResponse, error)
 	Get(context.Context, GetRequest) (GetResponse, error)
 	IncreaseClick(context.Context, IncreaseClickRequest) error
+	Create(context.Context, CreateRequest) (CreateResponse, error)
+	Edit(context.Context, EditRequest) (EditResponse, error)
+	Remove(context.Context, RemoveRequest) (RemoveResponse, error)
 }
 
 type GetRequest struct {
@@ -35,8 +38,11 @@ func (r GetRequest) Validate() error {
 	return err.Err()
 }
 
+// GetResponse has RedirectTo set to the current slug when the category was
+// requested by a slug it had before.
 type GetResponse struct {
-	Data Category
+	Data       Category
+	RedirectTo string
 }
 
 func (r GetResponse) Validate() error {
@@ -56,17 +62,25 @@ type ListRequest struct {
 	AllowDeleted   bool
 	AllowInvisible bool
 	IDRefs         IDs
+	// First pages by cursor instead of Page and Limit, it lists the first
+	// categories after the After cursor or from the start when After is zero.
+	First int
+	After Cursor
 }
 
 func (r ListRequest) Validate() error {
 	var err zeroerror.Error
 
-	if r.Page < 1 {
-		err.Add(ErrInvalidPage)
-	}
+	if r.First == 0 && r.After.IsZero() {
+		if r.Page < 1 {
+			err.Add(ErrInvalidPage)
+		}
 
-	if r.Limit < 1 {
-		err.Add(ErrInvalidLimit)
+		if r.Limit < 1 {
+			err.Add(ErrInvalidLimit)
+		}
+	} else if r.First < 1 {
+		err.Add(ErrInvalidFirst)
 	}
 
 	if ve := r.Language.Validate(); ve != nil {
@@ -80,8 +94,12 @@ func (r ListRequest) Validate() error {
 	return err.Err()
 }
 
+// ListResponse has a cursor for every category in Data when the request
+// paged by cursor, Data.Total is not counted then.
 type ListResponse struct {
-	Data Categories
+	Data        Categories
+	Cursors     []Cursor
+	HasNextPage bool
 }
 
 func (r ListResponse) Validate() error {
@@ -112,3 +130,121 @@ func (r IncreaseClickRequest) Validate() error {
 
 	return err.Err()
 }
+
+type CreateRequest struct {
+	Slug   string
+	Status Status
+	Texts  map[Language]Texts
+}
+
+func (r CreateRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Slug == "", ErrEmptySlug)
+
+	if ve := r.Status.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidStatus, ve))
+	}
+
+	if ve := validateTexts(r.Texts); ve != nil {
+		err.Add(ve)
+	}
+
+	return err.Err()
+}
+
+type CreateResponse struct {
+	Data Category
+}
+
+func (r CreateResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+type EditRequest struct {
+	ID     int
+	Slug   string
+	Status Status
+	Texts  map[Language]Texts
+}
+
+func (r EditRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID <= 0, ErrInvalidID)
+	err.AddIf(r.Slug == "", ErrEmptySlug)
+
+	if ve := r.Status.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidStatus, ve))
+	}
+
+	if ve := validateTexts(r.Texts); ve != nil {
+		err.Add(ve)
+	}
+
+	return err.Err()
+}
+
+type EditResponse struct {
+	Data Category
+}
+
+func (r EditResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+type RemoveRequest struct {
+	ID   int
+	Slug string
+}
+
+func (r RemoveRequest) Validate() error {
+	var err zeroerror.Error
+
+	if r.ID <= 0 && r.Slug == "" {
+		err.Add(fmt.Errorf("id and slug are both empty"))
+	}
+
+	return err.Err()
+}
+
+type RemoveResponse struct {
+}
+
+func (r RemoveResponse) Validate() error {
+	var err zeroerror.Error
+
+	return err.Err()
+}
+
+func validateTexts(texts map[Language]Texts) error {
+	var err zeroerror.Error
+
+	if len(texts) == 0 {
+		err.Add(fmt.Errorf("%w: no texts", ErrInvalidText))
+	}
+
+	for l, t := range texts {
+		if ve := l.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w: %w", ErrInvalidLanguage, ve))
+		}
+
+		if ve := t.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at language %q: %w", ErrInvalidText, l, ve))
+		}
+	}
+
+	return err.Err()
+}
@@ -77,9 +77,19 @@ func (c category) toDomain() domain.Category {
 	}
 }
 func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindResult, error) {
+	isKeyset := q.First > 0
+
+	limit := q.Limit
+	if isKeyset {
+		// One more than asked tells if there is a next page.
+		limit = q.First + 1
+	}
+
 	sqlQuery, err := templateToSQL(
 		"find_categories",
 		templateQuery{
+			"Keyset":         isKeyset,
+			"After":          !q.After.IsZero(),
 			"AllowDeleted":   q.AllowDeleted,
 			"AllowInvisible": q.AllowInvisible,
 			"FilterByIDRefs": len(q.IDRefs) > 0,
@@ -97,8 +107,10 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 				clicks,
 				created_at,
 				deleted_at,
-				published_at,
-				COUNT(*) OVER() AS total_count
+				published_at
+			{{ if not .Keyset }}
+				, COUNT(*) OVER() AS total_count
+			{{ end }}
 			FROM public.categories_view
 			WHERE language_code = :language_code
 			{{ if not .AllowDeleted }}
@@ -110,8 +122,16 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 			{{ if .FilterByIDRefs }}
 				AND id IN (:id_refs)
 			{{ end }}
-			LIMIT :limit
-			OFFSET :offset;
+			{{ if .Keyset }}
+				{{ if .After }}
+					AND id > :after_id
+				{{ end }}
+				ORDER BY id ASC
+				LIMIT :limit
+			{{ else }}
+				LIMIT :limit
+				OFFSET :offset
+			{{ end }};
 	`)
 	if err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to create SQL from template: %w", err)
@@ -119,9 +139,10 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 
 	query, args, err := sqlx.Named(sqlQuery, map[string]interface{}{
 		"language_code": q.Language.String(),
-		"limit":         q.Limit,
+		"limit":         limit,
 		"offset":        (q.Page - 1) * q.Limit,
 		"id_refs":       q.IDRefs,
+		"after_id":      q.After.ID,
 	})
 	if err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to generate named: %w", err)
@@ -150,11 +171,22 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 		},
 	}
 
+	if isKeyset && len(sqlRes) > q.First {
+		sqlRes = sqlRes[:q.First]
+		res.HasNextPage = true
+	}
+
 	if len(sqlRes) > 0 {
 		res.Data.Total = sqlRes[0].TotalCount
 
 		for _, category := range sqlRes {
 			res.Data.Data = append(res.Data.Data, category.toDomain())
+
+			if isKeyset {
+				res.Cursors = append(res.Cursors, domain.Cursor{
+					ID: category.ID,
+				})
+			}
 		}
 	}
 
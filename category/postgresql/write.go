@@ -0,0 +1,279 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+
+	"github.com/vediagames/platform/category/domain"
+)
+
+var langIDMap = map[domain.Language]int{
+	domain.LanguageEnglish: 1,
+	domain.LanguageEspanol: 2,
+}
+
+func (r repository) Insert(ctx context.Context, q domain.InsertQuery) (domain.InsertResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	if err = keepSlug(ctx, tx, 0, q.Slug); err != nil {
+		return domain.InsertResult{}, err
+	}
+
+	var id int
+
+	err = tx.GetContext(ctx, &id, `
+		INSERT INTO categories (
+			slug,
+			status,
+			published_at
+		)
+		VALUES (
+			$1,
+			$2,
+			CASE WHEN $2 = 'published' THEN NOW() END
+		)
+		RETURNING id;
+	`, q.Slug, q.Status.String())
+	if err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to insert: %w", err)
+	}
+
+	if err = replaceTexts(ctx, tx, id, q.Texts); err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to replace texts: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	repoRes, err := r.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    id,
+		Language: firstLanguage(q.Texts),
+	})
+	if err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	return domain.InsertResult(repoRes), nil
+}
+
+func (r repository) Update(ctx context.Context, q domain.UpdateQuery) (domain.UpdateResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	if err = keepSlug(ctx, tx, q.ID, q.Slug); err != nil {
+		return domain.UpdateResult{}, err
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE categories
+		SET
+			slug = $1,
+			status = $2,
+			published_at = CASE WHEN $2 = 'published' THEN COALESCE(published_at, NOW()) ELSE published_at END
+		WHERE id = $3
+	`, q.Slug, q.Status.String(), q.ID)
+	if err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to update: %w", err)
+	}
+
+	if err = handleModificationResults(res); err != nil {
+		return domain.UpdateResult{}, err
+	}
+
+	if err = replaceTexts(ctx, tx, q.ID, q.Texts); err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to replace texts: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	repoRes, err := r.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    q.ID,
+		Language: firstLanguage(q.Texts),
+	})
+	if err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	return domain.UpdateResult(repoRes), nil
+}
+
+func (r repository) Delete(ctx context.Context, q domain.DeleteQuery) (domain.DeleteResult, error) {
+	field, value := byIDOrSlug(q.ID, q.Slug)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.DeleteResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	// The row lock conflicts with the key share lock taken by inserts into
+	// game_categories, so no game can be linked between the count and the update.
+	var id int
+	err = tx.GetContext(ctx, &id, fmt.Sprintf(`
+		SELECT id FROM categories WHERE %s = $1 FOR UPDATE
+	`, field), value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.DeleteResult{}, domain.ErrNoData
+		}
+		return domain.DeleteResult{}, fmt.Errorf("failed to lock: %w", err)
+	}
+
+	var refs int
+	err = tx.GetContext(ctx, &refs, `
+		SELECT COUNT(DISTINCT g.id)
+		FROM game_categories AS ref
+			JOIN games AS g ON g.id = ref.game_id
+		WHERE ref.category_id = $1 AND g.status != 'deleted'
+	`, id)
+	if err != nil {
+		return domain.DeleteResult{}, fmt.Errorf("failed to count game refs: %w", err)
+	}
+
+	if refs > 0 {
+		return domain.DeleteResult{}, fmt.Errorf("%w: %d games still reference the category", domain.ErrReferencedByGames, refs)
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE categories SET status = 'deleted', deleted_at = NOW() WHERE id = $1
+	`, id)
+	if err != nil {
+		return domain.DeleteResult{}, fmt.Errorf("failed to exec: %w", err)
+	}
+
+	if err = handleModificationResults(res); err != nil {
+		return domain.DeleteResult{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.DeleteResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return domain.DeleteResult{}, nil
+}
+
+func (r repository) FindSlugRedirect(ctx context.Context, q domain.FindSlugRedirectQuery) (domain.FindSlugRedirectResult, error) {
+	var id int
+
+	err := r.db.GetContext(ctx, &id, `
+		SELECT category_id FROM public.category_slug_history WHERE slug = $1
+	`, q.Slug)
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.FindSlugRedirectResult{}, domain.ErrNoData
+	case err != nil:
+		return domain.FindSlugRedirectResult{}, fmt.Errorf("failed to get: %w", err)
+	}
+
+	return domain.FindSlugRedirectResult{
+		ID: id,
+	}, nil
+}
+
+func byIDOrSlug(id int, slug string) (string, any) {
+	if id > 0 {
+		return "id", id
+	}
+
+	return "slug", slug
+}
+
+// replaceTexts replaces the texts of the given languages, texts of other
+// languages are left untouched.
+func replaceTexts(ctx context.Context, tx *sqlx.Tx, id int, texts map[domain.Language]domain.Texts) error {
+	for lang, t := range texts {
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM category_texts WHERE category_id = $1 AND language_id = $2
+		`, id, langIDMap[lang])
+		if err != nil {
+			return fmt.Errorf("failed to delete text for language %q: %w", lang, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO category_texts (
+				category_id,
+				language_id,
+				name,
+				short_description,
+				description,
+				content
+			)
+			VALUES (
+				$1,
+				$2,
+				$3,
+				$4,
+				$5,
+				$6
+			)
+		`, id, langIDMap[lang], t.Name, t.ShortDescription, t.Description, t.Content)
+		if err != nil {
+			return fmt.Errorf("failed to insert text for language %q: %w", lang, err)
+		}
+	}
+
+	return nil
+}
+
+// keepSlug moves the current slug of the category to the history when it is about
+// to change to slug, and takes slug out of the history of any category. It has to
+// run before the new slug is written. A new category passes an id of 0.
+func keepSlug(ctx context.Context, tx *sqlx.Tx, id int, slug string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO public.category_slug_history (slug, category_id)
+		SELECT slug, id FROM public.categories WHERE id = $1 AND slug != $2
+		ON CONFLICT (slug) DO UPDATE
+		SET
+			category_id = EXCLUDED.category_id,
+			replaced_at = NOW()
+	`, id, slug)
+	if err != nil {
+		return fmt.Errorf("failed to insert slug history: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM public.category_slug_history WHERE slug = $1
+	`, slug)
+	if err != nil {
+		return fmt.Errorf("failed to delete slug history: %w", err)
+	}
+
+	return nil
+}
+
+// firstLanguage returns English when present in texts, otherwise any language
+// the category has texts for.
+func firstLanguage(texts map[domain.Language]domain.Texts) domain.Language {
+	if _, ok := texts[domain.LanguageEnglish]; ok {
+		return domain.LanguageEnglish
+	}
+
+	for lang := range texts {
+		return lang
+	}
+
+	return domain.LanguageEnglish
+}
+
+func rollback(ctx context.Context, tx *sqlx.Tx) {
+	if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		zerolog.Ctx(ctx).Error().Err(fmt.Errorf("failed to rollback: %w", err)).Send()
+	}
+}
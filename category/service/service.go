@@ -2,25 +2,31 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/rs/zerolog"
 	"github.com/vediagames/zeroerror"
 
 	"github.com/vediagames/platform/category/domain"
+	"github.com/vediagames/platform/events"
 )
 
 type service struct {
 	repository domain.Repository
+	publisher  events.Publisher
 }
 
 type Config struct {
 	Repository domain.Repository
+	Publisher  events.Publisher
 }
 
 func (c Config) Validate() error {
 	var err zeroerror.Error
 
 	err.AddIf(c.Repository == nil, fmt.Errorf("empty repository"))
+	err.AddIf(c.Publisher == nil, fmt.Errorf("empty publisher"))
 
 	return err.Err()
 }
@@ -32,6 +38,7 @@ func New(cfg Config) domain.Service {
 
 	return &service{
 		repository: cfg.Repository,
+		publisher:  cfg.Publisher,
 	}
 }
 
@@ -59,11 +66,50 @@ func (s service) Get(ctx context.Context, req domain.GetRequest) (domain.GetResp
 	}
 
 	repoRes, err := s.repository.FindOne(ctx, domain.FindOneQuery(req))
+	if errors.Is(err, domain.ErrNoData) && req.Field == domain.GetByFieldSlug {
+		return s.getRenamed(ctx, req)
+	}
 	if err != nil {
 		return domain.GetResponse{}, fmt.Errorf("failed to find one: %w", err)
 	}
 
-	res := domain.GetResponse(repoRes)
+	res := domain.GetResponse{
+		Data: repoRes.Data,
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.GetResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+// getRenamed gets a category by a slug it had before, ErrNoData is returned when
+// no category ever had the slug.
+func (s service) getRenamed(ctx context.Context, req domain.GetRequest) (domain.GetResponse, error) {
+	slug, _ := req.Value.(string)
+
+	redirectRes, err := s.repository.FindSlugRedirect(ctx, domain.FindSlugRedirectQuery{
+		Slug: slug,
+	})
+	if err != nil {
+		return domain.GetResponse{}, fmt.Errorf("failed to find slug redirect: %w", err)
+	}
+
+	repoRes, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    redirectRes.ID,
+		Language: req.Language,
+	})
+	if err != nil {
+		return domain.GetResponse{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	res := domain.GetResponse{
+		Data:       repoRes.Data,
+		RedirectTo: repoRes.Data.Slug,
+	}
+
 	if err := res.Validate(); err != nil {
 		return domain.GetResponse{}, fmt.Errorf("invalid response: %w", err)
 	}
@@ -85,5 +131,66 @@ func (s service) IncreaseClick(ctx context.Context, req domain.IncreaseClickRequ
 		return fmt.Errorf("failed to increase field: %w", err)
 	}
 
+	if err := s.publisher.Publish(ctx, events.CategoryClicked(events.CategoryRef{ID: req.ID})); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to publish events")
+	}
+
 	return nil
 }
+
+func (s service) Create(ctx context.Context, req domain.CreateRequest) (domain.CreateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.CreateResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	repoRes, err := s.repository.Insert(ctx, domain.InsertQuery(req))
+	if err != nil {
+		return domain.CreateResponse{}, fmt.Errorf("failed to insert: %w", err)
+	}
+
+	res := domain.CreateResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.CreateResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) Edit(ctx context.Context, req domain.EditRequest) (domain.EditResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.EditResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	repoRes, err := s.repository.Update(ctx, domain.UpdateQuery(req))
+	if err != nil {
+		return domain.EditResponse{}, fmt.Errorf("failed to update: %w", err)
+	}
+
+	res := domain.EditResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.EditResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) Remove(ctx context.Context, req domain.RemoveRequest) (domain.RemoveResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.RemoveResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	repoRes, err := s.repository.Delete(ctx, domain.DeleteQuery(req))
+	if errors.Is(err, domain.ErrReferencedByGames) {
+		return domain.RemoveResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+	if err != nil {
+		return domain.RemoveResponse{}, fmt.Errorf("failed to delete: %w", err)
+	}
+
+	res := domain.RemoveResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.RemoveResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
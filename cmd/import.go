@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/spf13/cobra"
+
+	"github.com/vediagames/platform/config"
+	gamepostgresql "github.com/vediagames/platform/game/postgresql"
+	gameservice "github.com/vediagames/platform/game/service"
+	importerdomain "github.com/vediagames/platform/importer/domain"
+)
+
+func ImportCmd() *cobra.Command {
+	var (
+		provider string
+		page     int
+		limit    int
+		site     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import provider games as invisible drafts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cfg := ctx.Value(config.ContextKey).(config.Config)
+
+			importerService, err := createSiteImporter(ctx, cfg, site)
+			if err != nil {
+				return err
+			}
+
+			res, err := importerService.Import(ctx, importerdomain.ImportRequest{
+				Provider: provider,
+				Page:     page,
+				Limit:    limit,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to import: %w", err)
+			}
+
+			for _, g := range res.Imported {
+				fmt.Printf("imported %s (id %d, %d images)\n", g.Slug, g.ID, g.Images)
+			}
+
+			for _, g := range res.Skipped {
+				fmt.Printf("skipped %s: %s\n", g.Slug, g.Reason)
+			}
+
+			fmt.Printf("imported %d, skipped %d\n", len(res.Imported), len(res.Skipped))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&provider, "provider", "", "Provider to import from, gamedistribution or gamemonetize")
+	cmd.Flags().IntVar(&page, "page", 1, "Page of the provider catalog to import from")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Number of provider games per page")
+	cmd.Flags().StringVar(&site, "site", "vediagames", "Site to import into, vediagames or mommagames")
+
+	if err := cmd.MarkFlagRequired("provider"); err != nil {
+		panic(fmt.Errorf("failed to mark provider flag as required: %w", err))
+	}
+
+	return cmd
+}
+
+// createSiteImporter creates an importer writing to the database of site,
+// vediagames or mommagames.
+func createSiteImporter(ctx context.Context, cfg config.Config, site string) (importerdomain.Service, error) {
+	db, err := openSiteDB(cfg, site)
+	if err != nil {
+		return nil, err
+	}
+
+	gameService := gameservice.New(gameservice.Config{
+		Repository: gamepostgresql.New(gamepostgresql.Config{
+			DB: db,
+		}),
+		EventRepository: gamepostgresql.NewEvent(gamepostgresql.Config{
+			DB: db,
+		}),
+		Publisher: createPublisher(ctx, cfg),
+	})
+
+	return createImporter(db, gameService, createBucketClient(ctx, cfg)), nil
+}
+
+// openSiteDB opens the database of site, vediagames or mommagames.
+func openSiteDB(cfg config.Config, site string) (*sqlx.DB, error) {
+	connectionString, ok := map[string]string{
+		"vediagames": cfg.PostgreSQL.VediaGamesConnectionString,
+		"mommagames": cfg.PostgreSQL.MommaGamesConnectionString,
+	}[site]
+	if !ok {
+		return nil, fmt.Errorf("unknown site: %q", site)
+	}
+
+	db, err := sqlx.Open("postgres", connectionString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open db connection: %w", err)
+	}
+
+	return db, nil
+}
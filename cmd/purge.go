@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vediagames/platform/config"
+	gamedomain "github.com/vediagames/platform/game/domain"
+	gamepostgresql "github.com/vediagames/platform/game/postgresql"
+	gameservice "github.com/vediagames/platform/game/service"
+)
+
+func PurgeCmd() *cobra.Command {
+	var (
+		site      string
+		ids       []int
+		retention time.Duration
+		dryRun    bool
+		asJSON    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Permanently remove deleted games past their retention",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cfg := ctx.Value(config.ContextKey).(config.Config)
+
+			db, err := openSiteDB(cfg, site)
+			if err != nil {
+				return err
+			}
+
+			gameService := gameservice.New(gameservice.Config{
+				Repository: gamepostgresql.New(gamepostgresql.Config{
+					DB: db,
+				}),
+				EventRepository: gamepostgresql.NewEvent(gamepostgresql.Config{
+					DB: db,
+				}),
+				Publisher:    createPublisher(ctx, cfg),
+				BucketClient: createBucketClient(ctx, cfg),
+			})
+
+			req := gamedomain.PurgeRequest{
+				IDs:    ids,
+				DryRun: dryRun,
+			}
+
+			if len(ids) == 0 {
+				if retention <= 0 {
+					retention = cfg.GamePurge.Retention
+				}
+
+				req.DeletedBefore = time.Now().Add(-retention)
+			}
+
+			res, err := gameService.Purge(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to purge: %w", err)
+			}
+
+			if asJSON {
+				if err := json.NewEncoder(os.Stdout).Encode(res.Data); err != nil {
+					return fmt.Errorf("failed to encode report: %w", err)
+				}
+
+				return nil
+			}
+
+			printPurgeReport(res, dryRun)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&site, "site", "vediagames", "Site to purge, vediagames or mommagames")
+	cmd.Flags().IntSliceVar(&ids, "id", nil, "Deleted games to purge regardless of retention")
+	cmd.Flags().DurationVar(&retention, "retention", 0, "Time a game stays deleted before it is purged, gamePurge.retention when zero")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report what would be removed without removing it")
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print the report as JSON")
+
+	return cmd
+}
+
+func printPurgeReport(res gamedomain.PurgeResponse, dryRun bool) {
+	verb := "purged"
+	if dryRun {
+		verb = "would purge"
+	}
+
+	failed := 0
+
+	for _, g := range res.Data {
+		if g.Error != "" {
+			failed++
+			fmt.Printf("failed %s (id %d): %s\n", g.Slug, g.ID, g.Error)
+		}
+
+		if g.Rows == nil {
+			continue
+		}
+
+		tables := make([]string, 0, len(g.Rows))
+		for table, n := range g.Rows {
+			if n > 0 {
+				tables = append(tables, fmt.Sprintf("%s %d", table, n))
+			}
+		}
+		sort.Strings(tables)
+
+		fmt.Printf("%s %s (id %d, deleted %s): %s, %d assets\n",
+			verb, g.Slug, g.ID, g.DeletedAt.Format(time.DateOnly), strings.Join(tables, ", "), g.Assets)
+	}
+
+	fmt.Printf("%s %d, failed %d\n", verb, len(res.Data)-failed, failed)
+}
@@ -2,15 +2,25 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"cloud.google.com/go/bigquery"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/go-chi/chi/v5"
+	"github.com/go-redis/redis/v8"
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/cors"
 	"github.com/rs/zerolog"
@@ -24,28 +34,57 @@ import (
 	categorypostgresql "github.com/vediagames/platform/category/postgresql"
 	categoryservice "github.com/vediagames/platform/category/service"
 	"github.com/vediagames/platform/config"
+	contactdomain "github.com/vediagames/platform/contact/domain"
+	contactpostgresql "github.com/vediagames/platform/contact/postgresql"
+	contactservice "github.com/vediagames/platform/contact/service"
+	"github.com/vediagames/platform/events"
+	eventsmemory "github.com/vediagames/platform/events/memory"
+	eventspubsub "github.com/vediagames/platform/events/pubsub"
 	"github.com/vediagames/platform/fetcher"
 	fetcherdomain "github.com/vediagames/platform/fetcher/domain"
 	"github.com/vediagames/platform/fetcher/gamedistribution"
+	"github.com/vediagames/platform/fetcher/gamemonetize"
+	gamebigquery "github.com/vediagames/platform/game/bigquery"
+	gamecounter "github.com/vediagames/platform/game/counter"
+	gamedomain "github.com/vediagames/platform/game/domain"
 	gamepostgresql "github.com/vediagames/platform/game/postgresql"
+	gamepublisher "github.com/vediagames/platform/game/publisher"
+	gameredis "github.com/vediagames/platform/game/redis"
 	gameservice "github.com/vediagames/platform/game/service"
 	gatewaygraphql "github.com/vediagames/platform/gateway/graphql"
 	imagedomain "github.com/vediagames/platform/image/domain"
 	"github.com/vediagames/platform/image/imagor"
 	imageservice "github.com/vediagames/platform/image/service"
+	importerdomain "github.com/vediagames/platform/importer/domain"
+	importerpostgresql "github.com/vediagames/platform/importer/postgresql"
+	importerservice "github.com/vediagames/platform/importer/service"
+	listpostgresql "github.com/vediagames/platform/list/postgresql"
+	listservice "github.com/vediagames/platform/list/service"
+	livenesspostgresql "github.com/vediagames/platform/liveness/postgresql"
+	livenessservice "github.com/vediagames/platform/liveness/service"
 	notificationdomain "github.com/vediagames/platform/notification/domain"
+	notificationqueue "github.com/vediagames/platform/notification/queue"
 	"github.com/vediagames/platform/notification/sendinblue"
+	notificationservice "github.com/vediagames/platform/notification/service"
+	"github.com/vediagames/platform/notification/smtp"
+	notificationtemplate "github.com/vediagames/platform/notification/template"
 	"github.com/vediagames/platform/quote"
+	recommendationpostgresql "github.com/vediagames/platform/recommendation/postgresql"
+	recommendationservice "github.com/vediagames/platform/recommendation/service"
 	searchservice "github.com/vediagames/platform/search/service"
 	sectionpostgresql "github.com/vediagames/platform/section/postgresql"
 	sectionservice "github.com/vediagames/platform/section/service"
 	sectionvalidationdata "github.com/vediagames/platform/section/service/validation/data"
 	sectionvalidationrequest "github.com/vediagames/platform/section/service/validation/request"
 	sessionbigquery "github.com/vediagames/platform/session/bigquery"
+	sessiondomain "github.com/vediagames/platform/session/domain"
 	sessionhttp "github.com/vediagames/platform/session/http"
 	sessionservice "github.com/vediagames/platform/session/service"
 	tagpostgresql "github.com/vediagames/platform/tag/postgresql"
 	tagservice "github.com/vediagames/platform/tag/service"
+	trendingpostgresql "github.com/vediagames/platform/trending/postgresql"
+	trendingservice "github.com/vediagames/platform/trending/service"
+	"github.com/vediagames/platform/webproxy"
 	webproxygraphql "github.com/vediagames/platform/webproxy/graphql"
 )
 
@@ -85,29 +124,21 @@ func startServer(ctx context.Context) error {
 			TableID:   "sessions",
 			DatasetID: "vediagames",
 		}),
+		Key: cfg.Session.Key,
 	})
 
-	emailClient := sendinblue.New(sendinblue.Config{
-		Token: cfg.SendInBlue.Key,
-		Client: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+	emailQueue := createEmailQueue(cfg)
+
+	notificationService := notificationservice.New(notificationservice.Config{
+		Renderer:    notificationtemplate.New(),
+		EmailClient: emailQueue,
 	})
 
 	fetcherClient := fetcher.New(fetcher.Config{
-		Clients: []fetcherdomain.Client{
-			gamedistribution.New(10),
-			//gamemonetize.New(10),
-		},
+		Clients: createProviders(),
 	})
 
-	bucketClient := s3.New(ctx, s3.Config{
-		Key:      cfg.S3.Key,
-		Secret:   cfg.S3.Secret,
-		Region:   cfg.S3.Region,
-		Endpoint: cfg.S3.Endpoint,
-		Bucket:   cfg.S3.Bucket,
-	})
+	bucketClient := createBucketClient(ctx, cfg)
 
 	imageProcessor := imagor.New(imagor.Config{
 		URL:    cfg.Imagor.URL,
@@ -127,32 +158,165 @@ func startServer(ctx context.Context) error {
 	})
 
 	quoteService := quote.New(mommaGamesDB)
-	authService := authservice.NewZero()
+	authService := authservice.New(authservice.Config{
+		Key:        cfg.Auth.Key,
+		CookieName: cfg.Auth.CookieName,
+	})
+
+	publisher := createPublisher(ctx, cfg)
+
+	vediaGamesOutbox := createGameOutbox(vediaGamesDB,
+		gamebigquery.NewSink(gamebigquery.Config{
+			Client:    client,
+			TableID:   "game_events",
+			DatasetID: "vediagames",
+		}),
+		gamepublisher.NewSink(gamepublisher.Config{
+			Publisher: publisher,
+			Site:      "vediagames",
+		}),
+	)
+
+	mommaGamesOutbox := createGameOutbox(mommaGamesDB,
+		gamebigquery.NewSink(gamebigquery.Config{
+			Client:    client,
+			TableID:   "game_events",
+			DatasetID: "mommagames",
+		}),
+		gamepublisher.NewSink(gamepublisher.Config{
+			Publisher: publisher,
+			Site:      "mommagames",
+		}),
+	)
+
+	vediaGamesCounters := createGameCounters(ctx, cfg, vediaGamesDB, "vediagames")
+	mommaGamesCounters := createGameCounters(ctx, cfg, mommaGamesDB, "mommagames")
+
+	vediaGamesScheduler := createGameScheduler(cfg, publisher, vediaGamesOutbox, vediaGamesCounters)
+	mommaGamesScheduler := createGameScheduler(cfg, publisher, mommaGamesOutbox, mommaGamesCounters)
+
+	vediaGamesTrending := createTrending(cfg, vediaGamesDB)
+	mommaGamesTrending := createTrending(cfg, mommaGamesDB)
+
+	// Workers stop only after the server has drained its requests, so their
+	// final flush includes everything those requests buffered.
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
 
-	vediaGamesGatewayResolver, vediaGamesGatewayHandler := createGateway(
+	var workers sync.WaitGroup
+
+	for _, counters := range []*gamecounter.Repository{vediaGamesCounters, mommaGamesCounters} {
+		workers.Add(1)
+
+		go func(counters *gamecounter.Repository) {
+			defer workers.Done()
+
+			if err := counters.Run(workerCtx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to run game counters")
+			}
+		}(counters)
+	}
+
+	for _, scheduler := range []*gameservice.Scheduler{vediaGamesScheduler, mommaGamesScheduler} {
+		workers.Add(1)
+
+		go func(scheduler *gameservice.Scheduler) {
+			defer workers.Done()
+
+			if err := scheduler.Run(workerCtx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to run game scheduler")
+			}
+		}(scheduler)
+	}
+
+	for _, trending := range []*trendingservice.Service{vediaGamesTrending, mommaGamesTrending} {
+		workers.Add(1)
+
+		go func(trending *trendingservice.Service) {
+			defer workers.Done()
+
+			if err := trending.Run(workerCtx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to run trending games")
+			}
+		}(trending)
+	}
+
+	for _, outbox := range []*gamepostgresql.Outbox{vediaGamesOutbox, mommaGamesOutbox} {
+		workers.Add(1)
+
+		go func(outbox *gamepostgresql.Outbox) {
+			defer workers.Done()
+
+			if err := outbox.Run(workerCtx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to run game event outbox")
+			}
+		}(outbox)
+	}
+
+	workers.Add(1)
+
+	go func() {
+		defer workers.Done()
+
+		if err := emailQueue.Run(workerCtx); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to run email queue")
+		}
+	}()
+
+	apqCache := webproxy.NewCache(ctx, webproxy.CacheConfig{
+		RedisAddress: cfg.RedisAddress,
+		Prefix:       cfg.APQ.Prefix,
+		TTL:          cfg.APQ.TTL,
+		LRUSize:      cfg.APQ.LRUSize,
+	})
+
+	vediaGamesGatewayResolver, vediaGamesGatewayHandler, vediaGamesLiveness := createGateway(
+		cfg,
 		vediaGamesDB,
-		emailClient,
+		notificationService,
 		bucketClient,
 		fetcherClient,
 		authService,
 		imageService,
 		quoteService,
+		publisher,
+		vediaGamesOutbox,
+		vediaGamesCounters,
+		apqCache,
 	)
-	_, vediagamesWebproxyHandler := createWebproxy(vediaGamesGatewayResolver)
+	_, vediagamesWebproxyHandler := createWebproxy(vediaGamesGatewayResolver, apqCache)
 
-	mommaGamesGatewayResolver, mommaGamesGatewayHandler := createGateway(
+	mommaGamesGatewayResolver, mommaGamesGatewayHandler, mommaGamesLiveness := createGateway(
+		cfg,
 		mommaGamesDB,
-		emailClient,
+		notificationService,
 		bucketClient,
 		fetcherClient,
 		authService,
 		imageService,
 		quoteService,
+		publisher,
+		mommaGamesOutbox,
+		mommaGamesCounters,
+		apqCache,
 	)
-	_, mommaGamesWebproxyHandler := createWebproxy(mommaGamesGatewayResolver)
+	_, mommaGamesWebproxyHandler := createWebproxy(mommaGamesGatewayResolver, apqCache)
+
+	for _, liveness := range []*livenessservice.Service{vediaGamesLiveness, mommaGamesLiveness} {
+		workers.Add(1)
+
+		go func(liveness *livenessservice.Service) {
+			defer workers.Done()
+
+			if err := liveness.Run(workerCtx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to run game liveness checks")
+			}
+		}(liveness)
+	}
 
 	httpCors := cors.New(cors.Options{
 		AllowedOrigins:   cfg.CORS.AllowedOrigins,
+		AllowedHeaders:   []string{"Origin", "Accept", "Content-Type", "X-Requested-With", "Authorization", "X-Session-ID"},
 		AllowCredentials: true,
 		Debug:            cfg.LogLevel == "debug",
 	})
@@ -163,7 +327,9 @@ func startServer(ctx context.Context) error {
 
 	router.Use(httpCors.Handler)
 	router.Use(loggerMiddleware(&logger))
-	//router.Use(authMiddleware(authService))
+	router.Use(authMiddleware(authService))
+	router.Use(clientIPMiddleware(parseCIDRs(cfg.TrustedProxies)))
+	router.Use(sessionMiddleware(sessionService))
 
 	router.Handle("/vediagames/gateway/graph", vediaGamesGatewayHandler)
 	router.Handle("/vediagames/webproxy/graph", vediagamesWebproxyHandler)
@@ -173,6 +339,18 @@ func startServer(ctx context.Context) error {
 
 	router.Handle("/session/create", sessionhttp.CreateHandler(sessionService))
 
+	router.HandleFunc("/stats/game-counters", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(map[string]gamecounter.Stats{
+			"vediagames": vediaGamesCounters.Stats(),
+			"mommagames": mommaGamesCounters.Stats(),
+		})
+		if err != nil {
+			zerolog.Ctx(r.Context()).Error().Err(err).Msg("failed to encode game counter stats")
+		}
+	})
+
 	router.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		zerolog.Ctx(r.Context()).Log().Msg("HELLO")
 		w.WriteHeader(http.StatusOK)
@@ -182,10 +360,40 @@ func startServer(ctx context.Context) error {
 		Int("port", cfg.Port).
 		Msgf("starting server on port %d", cfg.Port)
 
-	if err := http.ListenAndServe(fmt.Sprintf(":%d", cfg.Port), router); err != nil {
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.Port),
+		Handler: router,
+	}
+
+	signalCtx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+
+	shutdown := make(chan struct{})
+
+	go func() {
+		defer close(shutdown)
+
+		<-signalCtx.Done()
+
+		logger.Info().Msg("shutting down server")
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error().Err(err).Msg("failed to shut down server")
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("failed to start server: %w", err)
 	}
 
+	<-shutdown
+
+	stopWorkers()
+	workers.Wait()
+
 	return nil
 }
 
@@ -226,49 +434,359 @@ func loggerMiddleware(logger *zerolog.Logger) func(h http.Handler) http.Handler
 	}
 }
 
-// func authMiddleware(s authdomain.Service) func(h http.Handler) http.Handler {
-// 	return func(next http.Handler) http.Handler {
-// 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-// 			cookies := r.Header.Get("Cookie")
-
-// 			res, err := s.Authenticate(r.Context(), authdomain.AuthenticateRequest{
-// 				Cookies: cookies,
-// 			})
-// 			if err != nil {
-// 				zerolog.Ctx(r.Context()).Error().Msgf("failed to authenticate: %s", err)
-// 				next.ServeHTTP(w, r)
-// 				return
-// 			}
-
-// 			next.ServeHTTP(w, r.WithContext(
-// 				s.ToContext(r.Context(), res.User),
-// 			))
-// 		})
-// 	}
-// }
+func authMiddleware(s authdomain.Service) func(h http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			res, err := s.Authenticate(r.Context(), authdomain.AuthenticateRequest{
+				Cookies:       r.Header.Get("Cookie"),
+				Authorization: r.Header.Get("Authorization"),
+			})
+			if err != nil {
+				if !errors.Is(err, authdomain.ErrNoCredentials) {
+					zerolog.Ctx(r.Context()).Warn().Err(err).Msg("failed to authenticate")
+				}
+
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				s.ToContext(r.Context(), res.User),
+			))
+		})
+	}
+}
+
+// clientIPMiddleware passes the IP of the client on to the rate limits of the
+// contact and game services, see clientIP.
+func clientIPMiddleware(trusted []*net.IPNet) func(h http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(
+				contactdomain.IPToContext(r.Context(), clientIP(r, trusted)),
+			))
+		})
+	}
+}
+
+// clientIP returns the IP the request came from. Clients can set any header,
+// so CF-Connecting-IP and X-Forwarded-For are only read when the request was
+// forwarded by a trusted proxy. X-Forwarded-For is read from the right, the
+// first entry that is not a trusted proxy is the client.
+func clientIP(r *http.Request, trusted []*net.IPNet) string {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	if !isTrusted(ip, trusted) {
+		return ip
+	}
+
+	if cf := strings.TrimSpace(r.Header.Get("CF-Connecting-IP")); cf != "" {
+		return cf
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+
+		ip = hop
+
+		if !isTrusted(hop, trusted) {
+			break
+		}
+	}
+
+	return ip
+}
+
+func isTrusted(ip string, trusted []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+
+	for _, n := range trusted {
+		if n.Contains(parsed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseCIDRs parses CIDRs checked by config.Config.Validate.
+func parseCIDRs(cidrs []string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Errorf("failed to parse CIDR %q: %w", cidr, err))
+		}
+
+		nets = append(nets, n)
+	}
+
+	return nets
+}
+
+// sessionMiddleware passes the session from /session/create, sent as its
+// token in the X-Session-ID header, on to the resolvers. Tokens that were not
+// signed by the session service are ignored.
+func sessionMiddleware(s sessiondomain.Service) func(h http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.Header.Get("X-Session-ID")
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			res, err := s.Verify(r.Context(), sessiondomain.VerifyRequest{
+				Token: token,
+			})
+			if err != nil {
+				zerolog.Ctx(r.Context()).Warn().Err(err).Msg("failed to verify session")
+
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				sessiondomain.IDToContext(r.Context(), res.ID),
+			))
+		})
+	}
+}
+
+func createPublisher(ctx context.Context, cfg config.Config) events.Publisher {
+	if cfg.PubSub.ProjectID == "" {
+		return eventsmemory.New()
+	}
+
+	return eventspubsub.New(ctx, eventspubsub.Config{
+		ProjectID:       cfg.PubSub.ProjectID,
+		TopicID:         cfg.PubSub.TopicID,
+		CredentialsPath: cfg.PubSub.CredentialsPath,
+		EmulatorHost:    cfg.PubSub.EmulatorHost,
+	})
+}
+
+func createBucketClient(ctx context.Context, cfg config.Config) bucketdomain.Client {
+	return s3.New(ctx, s3.Config{
+		Key:      cfg.S3.Key,
+		Secret:   cfg.S3.Secret,
+		Region:   cfg.S3.Region,
+		Endpoint: cfg.S3.Endpoint,
+		Bucket:   cfg.S3.Bucket,
+	})
+}
+
+func createProviders() map[string]fetcherdomain.Client {
+	httpClient := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	return map[string]fetcherdomain.Client{
+		gamedistribution.Provider: gamedistribution.New(gamedistribution.Config{
+			URL:    gamedistribution.DefaultURL,
+			Client: httpClient,
+			Limit:  10,
+		}),
+		gamemonetize.Provider: gamemonetize.New(gamemonetize.Config{
+			URL:    gamemonetize.DefaultURL,
+			Client: httpClient,
+			Limit:  10,
+		}),
+	}
+}
+
+func createImporter(db *sqlx.DB, gameService gamedomain.Service, bucketClient bucketdomain.Client) importerdomain.Service {
+	return importerservice.New(importerservice.Config{
+		Providers:   createProviders(),
+		GameService: gameService,
+		MappingRepository: importerpostgresql.NewMapping(importerpostgresql.Config{
+			DB: db,
+		}),
+		LinkRepository: importerpostgresql.NewLink(importerpostgresql.Config{
+			DB: db,
+		}),
+		BucketClient: bucketClient,
+		HTTPClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	})
+}
+
+func createEmailQueue(cfg config.Config) *notificationqueue.Queue {
+	var emailClient notificationdomain.EmailClient
+
+	switch cfg.Email.Provider {
+	case "smtp":
+		emailClient = smtp.New(smtp.Config{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			Timeout:  10 * time.Second,
+		})
+	default:
+		emailClient = sendinblue.New(sendinblue.Config{
+			Token:   cfg.SendInBlue.Key,
+			BaseURL: cfg.SendInBlue.BaseURL,
+			Client: &http.Client{
+				Timeout: 10 * time.Second,
+			},
+		})
+	}
+
+	return notificationqueue.New(notificationqueue.Config{
+		EmailClient: emailClient,
+		Workers:     cfg.Email.Workers,
+		BufferSize:  cfg.Email.BufferSize,
+		MaxAttempts: cfg.Email.MaxAttempts,
+		Backoff:     cfg.Email.Backoff,
+		MaxBackoff:  cfg.Email.MaxBackoff,
+	})
+}
+
+func createContact(cfg config.Config, db *sqlx.DB, notificationService notificationdomain.Service) contactdomain.Service {
+	return contactservice.New(contactservice.Config{
+		Repository: contactpostgresql.New(contactpostgresql.Config{
+			DB: db,
+		}),
+		NotificationService: notificationService,
+		Recipient: notificationdomain.User{
+			Email: "antonio.jelic@vedia.si",
+			Name:  "Antonio Jelic",
+		},
+		IPLimit:         cfg.Contact.IPLimit,
+		SenderLimit:     cfg.Contact.SenderLimit,
+		Window:          cfg.Contact.Window,
+		ProofDifficulty: cfg.Contact.ProofDifficulty,
+		ProofMaxAge:     cfg.Contact.ProofMaxAge,
+		SpamThreshold:   cfg.Contact.SpamThreshold,
+	})
+}
+
+func createLiveness(cfg config.Config, db *sqlx.DB, gameService gamedomain.Service) *livenessservice.Service {
+	return livenessservice.New(livenessservice.Config{
+		Repository: livenesspostgresql.New(livenesspostgresql.Config{
+			DB: db,
+		}),
+		GameService: gameService,
+		Client: &http.Client{
+			Timeout: cfg.Liveness.Timeout,
+		},
+		Interval:     cfg.Liveness.Interval,
+		Concurrency:  cfg.Liveness.Concurrency,
+		HostInterval: cfg.Liveness.HostInterval,
+		MaxFailures:  cfg.Liveness.MaxFailures,
+	})
+}
+
+func createGameScheduler(
+	cfg config.Config,
+	publisher events.Publisher,
+	gameEventRepository gamedomain.EventRepository,
+	gameRepository gamedomain.Repository,
+) *gameservice.Scheduler {
+	return gameservice.NewScheduler(gameservice.SchedulerConfig{
+		Service: gameservice.New(gameservice.Config{
+			Repository:      gameRepository,
+			EventRepository: gameEventRepository,
+			Publisher:       publisher,
+		}),
+		Interval: cfg.GameScheduler.Interval,
+	})
+}
+
+func createTrending(cfg config.Config, db *sqlx.DB) *trendingservice.Service {
+	return trendingservice.New(trendingservice.Config{
+		Repository: trendingpostgresql.New(trendingpostgresql.Config{
+			DB: db,
+		}),
+		Interval:       cfg.Trending.Interval,
+		Window:         cfg.Trending.Window,
+		BaselineWindow: cfg.Trending.BaselineWindow,
+		HalfLife:       cfg.Trending.HalfLife,
+		Prior:          cfg.Trending.Prior,
+	})
+}
+
+func createGameOutbox(db *sqlx.DB, sinks ...gamedomain.EventSink) *gamepostgresql.Outbox {
+	return gamepostgresql.NewOutbox(gamepostgresql.OutboxConfig{
+		DB:            db,
+		Sinks:         sinks,
+		BufferSize:    4096,
+		BatchSize:     500,
+		FlushInterval: 2 * time.Second,
+		MaxAttempts:   10,
+	})
+}
+
+func createGameCounters(ctx context.Context, cfg config.Config, db *sqlx.DB, site string) *gamecounter.Repository {
+	store := gamecounter.NewMemoryStore()
+
+	if cfg.GameCounters.Store == "redis" {
+		client := redis.NewClient(&redis.Options{
+			Addr: cfg.RedisAddress,
+		})
+
+		if err := client.Ping(ctx).Err(); err != nil {
+			panic(fmt.Errorf("failed to ping redis: %w", err))
+		}
+
+		store = gameredis.NewCounterStore(gameredis.CounterConfig{
+			Client:  client,
+			Prefix:  site + ":game_counters",
+			LockTTL: time.Minute,
+		})
+	}
+
+	return gamecounter.New(gamecounter.Config{
+		Repository: gamepostgresql.New(gamepostgresql.Config{
+			DB: db,
+		}),
+		Store:         store,
+		FlushInterval: cfg.GameCounters.FlushInterval,
+		MergePending:  cfg.GameCounters.MergePending,
+	})
+}
 
 func createGateway(
+	cfg config.Config,
 	db *sqlx.DB,
-	emailClient notificationdomain.EmailClient,
+	notificationService notificationdomain.Service,
 	bucketClient bucketdomain.Client,
 	fetcherClient fetcherdomain.Client,
 	authService authdomain.Service,
 	imageService imagedomain.Service,
 	quoteService quote.Service,
-) (*gatewaygraphql.Resolver, *handler.Server) {
+	publisher events.Publisher,
+	gameEventRepository gamedomain.EventRepository,
+	gameRepository gamedomain.Repository,
+	apqCache graphql.Cache,
+) (*gatewaygraphql.Resolver, *handler.Server, *livenessservice.Service) {
 	gameService := gameservice.New(gameservice.Config{
-		Repository: gamepostgresql.New(gamepostgresql.Config{
-			DB: db,
-		}),
-		EventRepository: gamepostgresql.NewEvent(gamepostgresql.Config{
-			DB: db,
-		}),
+		Repository:      gameRepository,
+		EventRepository: gameEventRepository,
+		Publisher:       publisher,
+		EventLimit:      cfg.GameEvents.Limit,
+		EventWindow:     cfg.GameEvents.Window,
 	})
 
 	categoryService := categoryservice.New(categoryservice.Config{
 		Repository: categorypostgresql.New(categorypostgresql.Config{
 			DB: db,
 		}),
+		Publisher: publisher,
 	})
 
 	sectionService := sectionservice.New(sectionservice.Config{
@@ -286,6 +804,31 @@ func createGateway(
 		Repository: tagpostgresql.New(tagpostgresql.Config{
 			DB: db,
 		}),
+		Publisher: publisher,
+	})
+
+	listService := listservice.New(listservice.Config{
+		Repository: listpostgresql.New(listpostgresql.Config{
+			DB: db,
+		}),
+	})
+
+	importerService := createImporter(db, gameService, bucketClient)
+
+	livenessService := createLiveness(cfg, db, gameService)
+
+	contactService := createContact(cfg, db, notificationService)
+
+	recommendationService := recommendationservice.New(recommendationservice.Config{
+		Repository: recommendationpostgresql.New(recommendationpostgresql.Config{
+			DB: db,
+		}),
+		GameService:      gameService,
+		TagWeight:        cfg.Recommendation.TagWeight,
+		CategoryWeight:   cfg.Recommendation.CategoryWeight,
+		CoPlayWeight:     cfg.Recommendation.CoPlayWeight,
+		PopularityWeight: cfg.Recommendation.PopularityWeight,
+		CacheTTL:         cfg.Recommendation.CacheTTL,
 	})
 
 	searchService := searchservice.New(searchservice.Config{
@@ -294,18 +837,22 @@ func createGateway(
 	})
 
 	gatewayResolver := gatewaygraphql.NewResolver(gatewaygraphql.Config{
-		GameService:     gameService,
-		CategoryService: categoryService,
-		SectionService:  sectionService,
-		TagService:      tagService,
-		SearchService:   searchService,
-		EmailClient:     emailClient,
-		BucketClient:    bucketClient,
-		FetcherClient:   fetcherClient,
-		AuthService:     authService,
-		ImageService:    imageService,
-		ContentURL:      "https://content.vediagames.com",
-		QuoteService:    quoteService,
+		GameService:           gameService,
+		CategoryService:       categoryService,
+		SectionService:        sectionService,
+		TagService:            tagService,
+		SearchService:         searchService,
+		ContactService:        contactService,
+		BucketClient:          bucketClient,
+		FetcherClient:         fetcherClient,
+		AuthService:           authService,
+		ImageService:          imageService,
+		ContentURL:            "https://content.vediagames.com",
+		QuoteService:          quoteService,
+		ListService:           listService,
+		ImporterService:       importerService,
+		LivenessService:       livenessService,
+		RecommendationService: recommendationService,
 	})
 
 	gatewayHandler := handler.New(gatewaygraphql.NewSchema(gatewayResolver))
@@ -317,11 +864,14 @@ func createGateway(
 	gatewayHandler.AddTransport(transport.POST{})
 	gatewayHandler.AddTransport(transport.MultipartForm{})
 	gatewayHandler.Use(extension.Introspection{})
+	gatewayHandler.Use(extension.AutomaticPersistedQuery{
+		Cache: apqCache,
+	})
 
-	return gatewayResolver, gatewayHandler
+	return gatewayResolver, gatewayHandler, livenessService
 }
 
-func createWebproxy(gatewayResolver *gatewaygraphql.Resolver) (*webproxygraphql.Resolver, *handler.Server) {
+func createWebproxy(gatewayResolver *gatewaygraphql.Resolver, apqCache graphql.Cache) (*webproxygraphql.Resolver, *handler.Server) {
 	webproxyResolver := webproxygraphql.NewResolver(webproxygraphql.Config{
 		GatewayResolver: gatewayResolver,
 	})
@@ -333,6 +883,9 @@ func createWebproxy(gatewayResolver *gatewaygraphql.Resolver) (*webproxygraphql.
 	webproxyHandler.AddTransport(transport.MultipartForm{})
 	webproxyHandler.Use(extension.Introspection{})
 	webproxyHandler.Use(extension.FixedComplexityLimit(290))
+	webproxyHandler.Use(extension.AutomaticPersistedQuery{
+		Cache: apqCache,
+	})
 
 	return &webproxyResolver, webproxyHandler
 }
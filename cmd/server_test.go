@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_clientIP(t *testing.T) {
+	trusted := parseCIDRs([]string{"10.0.0.0/8"})
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		cfConnecting string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			name:         "direct client",
+			remoteAddr:   "203.0.113.7:1234",
+			cfConnecting: "198.51.100.1",
+			forwardedFor: []string{"198.51.100.2"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "cloudflare behind trusted proxy",
+			remoteAddr:   "10.0.0.2:1234",
+			cfConnecting: "198.51.100.1",
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "spoofed first hop",
+			remoteAddr:   "10.0.0.2:1234",
+			forwardedFor: []string{"1.2.3.4, 203.0.113.7", "10.0.0.3"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "only trusted hops",
+			remoteAddr:   "10.0.0.2:1234",
+			forwardedFor: []string{"10.0.0.4, 10.0.0.3"},
+			want:         "10.0.0.4",
+		},
+		{
+			name:       "trusted proxy without headers",
+			remoteAddr: "10.0.0.2:1234",
+			want:       "10.0.0.2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+
+			if tt.cfConnecting != "" {
+				r.Header.Set("CF-Connecting-IP", tt.cfConnecting)
+			}
+
+			for _, v := range tt.forwardedFor {
+				r.Header.Add("X-Forwarded-For", v)
+			}
+
+			if got := clientIP(r, trusted); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vediagames/platform/config"
+	importerdomain "github.com/vediagames/platform/importer/domain"
+)
+
+func SyncCmd() *cobra.Command {
+	var (
+		provider string
+		site     string
+		dryRun   bool
+		asJSON   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sync imported games with their provider catalogs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cfg := ctx.Value(config.ContextKey).(config.Config)
+
+			importerService, err := createSiteImporter(ctx, cfg, site)
+			if err != nil {
+				return err
+			}
+
+			providers := []string{provider}
+			if provider == "" {
+				providers = providers[:0]
+				for p := range createProviders() {
+					providers = append(providers, p)
+				}
+				sort.Strings(providers)
+			}
+
+			reports := make([]importerdomain.SyncResponse, 0, len(providers))
+
+			for _, p := range providers {
+				res, err := importerService.Sync(ctx, importerdomain.SyncRequest{
+					Provider: p,
+					DryRun:   dryRun,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to sync %s: %w", p, err)
+				}
+
+				reports = append(reports, res)
+			}
+
+			if asJSON {
+				if err := json.NewEncoder(os.Stdout).Encode(reports); err != nil {
+					return fmt.Errorf("failed to encode report: %w", err)
+				}
+
+				return nil
+			}
+
+			for _, res := range reports {
+				printSyncReport(res)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&provider, "provider", "", "Provider to sync, all providers when empty")
+	cmd.Flags().StringVar(&site, "site", "vediagames", "Site to sync, vediagames or mommagames")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report changes without applying them")
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print the report as JSON")
+
+	return cmd
+}
+
+func printSyncReport(res importerdomain.SyncResponse) {
+	fmt.Printf("%s: %d games in catalog\n", res.Provider, res.Listed)
+
+	for _, g := range res.Linked {
+		fmt.Printf("linked %s (id %d)\n", g.Slug, g.ID)
+	}
+
+	for _, g := range res.Updated {
+		for _, c := range g.Changes {
+			fmt.Printf("updated %s (id %d): %s %q -> %q\n", g.Slug, g.ID, c.Field, c.Old, c.New)
+		}
+	}
+
+	for _, g := range res.Missing {
+		fmt.Printf("missing %s (id %d)\n", g.Slug, g.ID)
+	}
+
+	for _, g := range res.Removed {
+		fmt.Printf("removed %s (id %d), set invisible\n", g.Slug, g.ID)
+	}
+
+	for _, g := range res.Reappeared {
+		fmt.Printf("reappeared %s (id %d)\n", g.Slug, g.ID)
+	}
+
+	for _, g := range res.Failed {
+		fmt.Printf("failed id %d: %s\n", g.ID, g.Reason)
+	}
+
+	fmt.Printf("linked %d, updated %d, missing %d, removed %d, reappeared %d, failed %d\n",
+		len(res.Linked), len(res.Updated), len(res.Missing), len(res.Removed), len(res.Reappeared), len(res.Failed))
+}
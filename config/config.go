@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/vediagames/zeroerror"
@@ -25,14 +27,48 @@ type Config struct {
 			Stub      string `mapstructure:"stub"`
 		} `mapstructure:"path"`
 	} `mapstructure:"postgresql"`
+	// Email sends notifications through a retrying queue.
+	Email struct {
+		// Provider is either "sendinblue" or "smtp".
+		Provider    string        `mapstructure:"provider"`
+		Workers     int           `mapstructure:"workers"`
+		BufferSize  int           `mapstructure:"bufferSize"`
+		MaxAttempts int           `mapstructure:"maxAttempts"`
+		Backoff     time.Duration `mapstructure:"backoff"`
+		MaxBackoff  time.Duration `mapstructure:"maxBackoff"`
+	} `mapstructure:"email"`
 	SendInBlue struct {
-		Key string `mapstructure:"key"`
+		Key     string `mapstructure:"key"`
+		BaseURL string `mapstructure:"baseURL"`
 	} `mapstructure:"sendinblue"`
+	SMTP struct {
+		Host     string `mapstructure:"host"`
+		Port     int    `mapstructure:"port"`
+		Username string `mapstructure:"username"`
+		Password string `mapstructure:"password"`
+	} `mapstructure:"smtp"`
+	// Contact screens sendEmail submissions before they are emailed.
+	Contact struct {
+		IPLimit         int           `mapstructure:"ipLimit"`
+		SenderLimit     int           `mapstructure:"senderLimit"`
+		Window          time.Duration `mapstructure:"window"`
+		ProofDifficulty int           `mapstructure:"proofDifficulty"`
+		ProofMaxAge     time.Duration `mapstructure:"proofMaxAge"`
+		SpamThreshold   int           `mapstructure:"spamThreshold"`
+	} `mapstructure:"contact"`
 	CORS struct {
 		AllowedOrigins []string `mapstructure:"allowedOrigins"`
 	} `mapstructure:"cors"`
-	RedisAddress string `mapstructure:"redisAddress"`
-	BigQuery     struct {
+	// TrustedProxies are the CIDRs of the proxies in front of the server. The
+	// client IP headers are only read from requests they forward.
+	TrustedProxies []string `mapstructure:"trustedProxies"`
+	RedisAddress   string   `mapstructure:"redisAddress"`
+	APQ            struct {
+		Prefix  string        `mapstructure:"prefix"`
+		TTL     time.Duration `mapstructure:"ttl"`
+		LRUSize int           `mapstructure:"lruSize"`
+	} `mapstructure:"apq"`
+	BigQuery struct {
 		ProjectID       string `mapstructure:"projectID"`
 		CredentialsPath string `mapstructure:"credentialsPath"`
 	} `mapstructure:"bigQuery"`
@@ -48,6 +84,64 @@ type Config struct {
 		Bucket   string `mapstructure:"bucket"`
 	}
 	QuotesCSV string `mapstructure:"quotesCSV"`
+	Auth      struct {
+		Key        string `mapstructure:"key"`
+		CookieName string `mapstructure:"cookieName"`
+	} `mapstructure:"auth"`
+	Session struct {
+		// Key signs the session tokens sent in the X-Session-ID header.
+		Key string `mapstructure:"key"`
+	} `mapstructure:"session"`
+	GameCounters struct {
+		// Store is either "memory" or "redis", the latter uses redisAddress.
+		Store         string        `mapstructure:"store"`
+		FlushInterval time.Duration `mapstructure:"flushInterval"`
+		MergePending  bool          `mapstructure:"mergePending"`
+	} `mapstructure:"gameCounters"`
+	// GameEvents throttles the plays logged from one visitor and one IP.
+	GameEvents struct {
+		Limit  int           `mapstructure:"limit"`
+		Window time.Duration `mapstructure:"window"`
+	} `mapstructure:"gameEvents"`
+	// Liveness checks the URLs of published games.
+	Liveness struct {
+		Interval     time.Duration `mapstructure:"interval"`
+		Concurrency  int           `mapstructure:"concurrency"`
+		HostInterval time.Duration `mapstructure:"hostInterval"`
+		Timeout      time.Duration `mapstructure:"timeout"`
+		MaxFailures  int           `mapstructure:"maxFailures"`
+	} `mapstructure:"liveness"`
+	// Trending computes the trending snapshot from play events.
+	Trending struct {
+		Interval       time.Duration `mapstructure:"interval"`
+		Window         time.Duration `mapstructure:"window"`
+		BaselineWindow time.Duration `mapstructure:"baselineWindow"`
+		HalfLife       time.Duration `mapstructure:"halfLife"`
+		Prior          float64       `mapstructure:"prior"`
+	} `mapstructure:"trending"`
+	// Recommendation ranks similar games.
+	Recommendation struct {
+		TagWeight        float64       `mapstructure:"tagWeight"`
+		CategoryWeight   float64       `mapstructure:"categoryWeight"`
+		CoPlayWeight     float64       `mapstructure:"coPlayWeight"`
+		PopularityWeight float64       `mapstructure:"popularityWeight"`
+		CacheTTL         time.Duration `mapstructure:"cacheTTL"`
+	} `mapstructure:"recommendation"`
+	// GameScheduler publishes and unpublishes games by their schedules.
+	GameScheduler struct {
+		Interval time.Duration `mapstructure:"interval"`
+	} `mapstructure:"gameScheduler"`
+	// GamePurge removes deleted games for good, see the purge command.
+	GamePurge struct {
+		Retention time.Duration `mapstructure:"retention"`
+	} `mapstructure:"gamePurge"`
+	// PubSub is optional, events are kept in memory when projectID is empty.
+	PubSub struct {
+		ProjectID       string `mapstructure:"projectID"`
+		TopicID         string `mapstructure:"topicID"`
+		CredentialsPath string `mapstructure:"credentialsPath"`
+		EmulatorHost    string `mapstructure:"emulatorHost"`
+	} `mapstructure:"pubsub"`
 }
 
 func (c Config) Validate() error {
@@ -58,11 +152,13 @@ func (c Config) Validate() error {
 	err.AddIf(c.Port == 0, fmt.Errorf("port is not set"))
 	err.AddIf(c.PostgreSQL.VediaGamesConnectionString == "", fmt.Errorf("postgresql.vediaGamesConnectionString is not set"))
 	err.AddIf(c.PostgreSQL.MommaGamesConnectionString == "", fmt.Errorf("postgresql.mommaGamesConnectionString is not set"))
-	err.AddIf(c.SendInBlue.Key == "", fmt.Errorf("sendinblue.key is not set"))
 	err.AddIf(len(c.CORS.AllowedOrigins) == 0, fmt.Errorf("cors.allowedOrigins is not set"))
 	err.AddIf(c.PostgreSQL.Path.Migration == "", fmt.Errorf("postgresql.path.migration is not set"))
 	err.AddIf(c.PostgreSQL.Path.Stub == "", fmt.Errorf("postgresql.path.stub is not set"))
 	err.AddIf(c.RedisAddress == "", fmt.Errorf("redisAddress is not set"))
+	err.AddIf(c.APQ.Prefix == "", fmt.Errorf("apq.prefix is not set"))
+	err.AddIf(c.APQ.TTL <= 0, fmt.Errorf("apq.ttl is not set"))
+	err.AddIf(c.APQ.LRUSize < 1, fmt.Errorf("apq.lruSize is not set"))
 	err.AddIf(c.BigQuery.ProjectID == "", fmt.Errorf("bigquery.projectID is not set"))
 	err.AddIf(c.BigQuery.CredentialsPath == "", fmt.Errorf("bigquery.credentialsPath is not set"))
 	err.AddIf(c.Imagor.URL == "", fmt.Errorf("imagor.URL is not set"))
@@ -71,6 +167,66 @@ func (c Config) Validate() error {
 	err.AddIf(c.S3.Secret == "", fmt.Errorf("s3.secret is not set"))
 	err.AddIf(c.S3.Endpoint == "", fmt.Errorf("s3.endpoint is not set"))
 	err.AddIf(c.S3.Bucket == "", fmt.Errorf("s3.bucket is not set"))
+	err.AddIf(c.Auth.Key == "", fmt.Errorf("auth.key is not set"))
+	err.AddIf(c.Auth.CookieName == "", fmt.Errorf("auth.cookieName is not set"))
+	err.AddIf(c.Session.Key == "", fmt.Errorf("session.key is not set"))
+
+	err.AddIf(c.Email.Provider != "sendinblue" && c.Email.Provider != "smtp",
+		fmt.Errorf("email.provider must be sendinblue or smtp"))
+	err.AddIf(c.Email.Workers < 1, fmt.Errorf("email.workers is not set"))
+	err.AddIf(c.Email.BufferSize < 1, fmt.Errorf("email.bufferSize is not set"))
+	err.AddIf(c.Email.MaxAttempts < 1, fmt.Errorf("email.maxAttempts is not set"))
+	err.AddIf(c.Email.Backoff <= 0, fmt.Errorf("email.backoff is not set"))
+	err.AddIf(c.Email.MaxBackoff < c.Email.Backoff, fmt.Errorf("email.maxBackoff is less than email.backoff"))
+
+	if c.Email.Provider == "sendinblue" {
+		err.AddIf(c.SendInBlue.Key == "", fmt.Errorf("sendinblue.key is not set"))
+		err.AddIf(c.SendInBlue.BaseURL == "", fmt.Errorf("sendinblue.baseURL is not set"))
+	}
+
+	if c.Email.Provider == "smtp" {
+		err.AddIf(c.SMTP.Host == "", fmt.Errorf("smtp.host is not set"))
+		err.AddIf(c.SMTP.Port == 0, fmt.Errorf("smtp.port is not set"))
+	}
+
+	err.AddIf(c.Contact.IPLimit < 1, fmt.Errorf("contact.ipLimit is not set"))
+	err.AddIf(c.Contact.SenderLimit < 1, fmt.Errorf("contact.senderLimit is not set"))
+	err.AddIf(c.Contact.Window <= 0, fmt.Errorf("contact.window is not set"))
+	err.AddIf(c.Contact.ProofDifficulty > 0 && c.Contact.ProofMaxAge <= 0, fmt.Errorf("contact.proofMaxAge is not set"))
+	err.AddIf(c.Contact.SpamThreshold < 1, fmt.Errorf("contact.spamThreshold is not set"))
+
+	err.AddIf(c.GameCounters.Store != "memory" && c.GameCounters.Store != "redis",
+		fmt.Errorf("gameCounters.store must be memory or redis"))
+	err.AddIf(c.GameCounters.FlushInterval <= 0, fmt.Errorf("gameCounters.flushInterval is not set"))
+	err.AddIf(c.GameEvents.Limit < 1, fmt.Errorf("gameEvents.limit is not set"))
+	err.AddIf(c.GameEvents.Window <= 0, fmt.Errorf("gameEvents.window is not set"))
+	err.AddIf(c.Liveness.Interval <= 0, fmt.Errorf("liveness.interval is not set"))
+	err.AddIf(c.Liveness.Concurrency < 1, fmt.Errorf("liveness.concurrency is not set"))
+	err.AddIf(c.Liveness.Timeout <= 0, fmt.Errorf("liveness.timeout is not set"))
+	err.AddIf(c.Liveness.MaxFailures < 1, fmt.Errorf("liveness.maxFailures is not set"))
+	err.AddIf(c.Trending.Interval <= 0, fmt.Errorf("trending.interval is not set"))
+	err.AddIf(c.Trending.Window <= 0, fmt.Errorf("trending.window is not set"))
+	err.AddIf(c.Trending.BaselineWindow <= c.Trending.Window,
+		fmt.Errorf("trending.baselineWindow is not longer than trending.window"))
+	err.AddIf(c.Trending.HalfLife <= 0, fmt.Errorf("trending.halfLife is not set"))
+	err.AddIf(c.Trending.Prior <= 0, fmt.Errorf("trending.prior is not set"))
+	err.AddIf(c.Recommendation.TagWeight <= 0 && c.Recommendation.CategoryWeight <= 0,
+		fmt.Errorf("recommendation.tagWeight or recommendation.categoryWeight is not set"))
+	err.AddIf(c.Recommendation.CacheTTL <= 0, fmt.Errorf("recommendation.cacheTTL is not set"))
+	err.AddIf(c.GameScheduler.Interval <= 0, fmt.Errorf("gameScheduler.interval is not set"))
+	err.AddIf(c.GamePurge.Retention <= 0, fmt.Errorf("gamePurge.retention is not set"))
+
+	if c.PubSub.ProjectID != "" {
+		err.AddIf(c.PubSub.TopicID == "", fmt.Errorf("pubsub.topicID is not set"))
+		err.AddIf(c.PubSub.EmulatorHost == "" && c.PubSub.CredentialsPath == "",
+			fmt.Errorf("pubsub.credentialsPath is not set"))
+	}
+
+	for _, proxy := range c.TrustedProxies {
+		if _, _, pe := net.ParseCIDR(proxy); pe != nil {
+			err.Add(fmt.Errorf("trustedProxies includes invalid CIDR %q: %w", proxy, pe))
+		}
+	}
 
 	for _, origin := range c.CORS.AllowedOrigins {
 		err.AddIf(origin == "", fmt.Errorf("cors.allowedOrigins includes empty origin"))
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vediagames/zeroerror"
+)
+
+// Reason is why a contact form submission was rejected.
+type Reason string
+
+func (r Reason) Validate() error {
+	switch r {
+	case ReasonHoneypot, ReasonInvalidProof, ReasonInvalidEmail, ReasonRateLimited, ReasonSpam:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrInvalidValue, r)
+}
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+// Disclosed reports whether the sender is told about the rejection. Bots
+// tripping the honeypot or the content filter are told the message was
+// sent, so they learn nothing from the response.
+func (r Reason) Disclosed() bool {
+	switch r {
+	case ReasonInvalidProof, ReasonInvalidEmail, ReasonRateLimited:
+		return true
+	}
+
+	return false
+}
+
+const (
+	ReasonHoneypot     Reason = "honeypot"
+	ReasonInvalidProof Reason = "invalid-proof"
+	ReasonInvalidEmail Reason = "invalid-email"
+	ReasonRateLimited  Reason = "rate-limited"
+	ReasonSpam         Reason = "spam"
+)
+
+// Rejection is a rejected submission kept for review.
+type Rejection struct {
+	ID      int
+	IP      string
+	From    string
+	Name    string
+	Subject string
+	Body    string
+	Reason  Reason
+	// Score is the content score, only computed for submissions that got as
+	// far as the content filter.
+	Score     int
+	CreatedAt time.Time
+}
+
+func (r Rejection) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID < 1, fmt.Errorf("%w: ID", ErrInvalidValue))
+	err.AddIf(r.CreatedAt.IsZero(), fmt.Errorf("%w: created at", ErrInvalidValue))
+
+	if ve := r.Reason.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid reason: %w", ve))
+	}
+
+	return err.Err()
+}
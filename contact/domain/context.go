@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+type contextKey string
+
+const ipContextKey contextKey = "contact_ip"
+
+// IPToContext stores the client IP that submissions from ctx are limited by.
+func IPToContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ipContextKey, ip)
+}
+
+// IPFromContext returns the IP set by IPToContext, or an empty string.
+func IPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(ipContextKey).(string)
+	return ip
+}
@@ -0,0 +1,18 @@
+package domain
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrInvalidValue = Error("invalid value")
+	ErrInvalidPage  = Error("invalid page")
+	ErrInvalidLimit = Error("invalid limit")
+	ErrInvalidData  = Error("invalid data")
+	ErrEmptyIP      = Error("empty IP")
+	// ErrRejected is returned for submissions the sender should be told
+	// about, see Reason.Disclosed.
+	ErrRejected = Error("rejected")
+)
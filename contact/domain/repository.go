@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+)
+
+type Repository interface {
+	InsertRejection(context.Context, InsertRejectionQuery) error
+	FindRejections(context.Context, FindRejectionsQuery) (FindRejectionsResult, error)
+}
+
+type InsertRejectionQuery struct {
+	IP      string
+	From    string
+	Name    string
+	Subject string
+	Body    string
+	Reason  Reason
+	Score   int
+}
+
+type FindRejectionsQuery struct {
+	Page  int
+	Limit int
+}
+
+type FindRejectionsResult struct {
+	Data  []Rejection
+	Total int
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+)
+
+// Service screens contact form submissions before they are emailed.
+type Service interface {
+	Submit(context.Context, SubmitRequest) (SubmitResponse, error)
+	ListRejections(context.Context, ListRejectionsRequest) (ListRejectionsResponse, error)
+}
+
+type SubmitRequest struct {
+	IP      string
+	From    string
+	Name    string
+	Subject string
+	Body    string
+	// Honeypot is a form field hidden from people, anything in it was
+	// filled in by a bot.
+	Honeypot string
+	// Proof is the proof of work, "<unix timestamp>:<nonce>".
+	Proof string
+}
+
+func (r SubmitRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.IP == "", ErrEmptyIP)
+	err.AddIf(r.From == "", fmt.Errorf("%w: from", ErrInvalidValue))
+	err.AddIf(r.Name == "", fmt.Errorf("%w: name", ErrInvalidValue))
+	err.AddIf(r.Subject == "", fmt.Errorf("%w: subject", ErrInvalidValue))
+	err.AddIf(r.Body == "", fmt.Errorf("%w: body", ErrInvalidValue))
+
+	return err.Err()
+}
+
+type SubmitResponse struct {
+	Accepted bool
+	// Reason is set when the submission was not accepted.
+	Reason Reason
+}
+
+type ListRejectionsRequest struct {
+	Page  int
+	Limit int
+}
+
+func (r ListRejectionsRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Page < 1, ErrInvalidPage)
+	err.AddIf(r.Limit < 1 || r.Limit > 100, ErrInvalidLimit)
+
+	return err.Err()
+}
+
+type ListRejectionsResponse struct {
+	Data  []Rejection
+	Total int
+}
+
+func (r ListRejectionsResponse) Validate() error {
+	var err zeroerror.Error
+
+	for i, rejection := range r.Data {
+		if ve := rejection.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at index %d: %w", ErrInvalidData, i, ve))
+		}
+	}
+
+	err.AddIf(r.Total < 0, fmt.Errorf("%w: negative total", ErrInvalidData))
+
+	return err.Err()
+}
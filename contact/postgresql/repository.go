@@ -0,0 +1,120 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/contact/domain"
+)
+
+type Config struct {
+	DB *sqlx.DB
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.DB == nil, fmt.Errorf("empty DB"))
+
+	if pingErr := c.DB.Ping(); pingErr != nil {
+		err.Add(fmt.Errorf("failed to ping: %w", pingErr))
+	}
+
+	return err.Err()
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+func New(cfg Config) domain.Repository {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &repository{
+		db: cfg.DB,
+	}
+}
+
+func (r repository) InsertRejection(ctx context.Context, q domain.InsertRejectionQuery) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO public.contact_rejections (
+			ip,
+			sender_email,
+			name,
+			subject,
+			body,
+			reason,
+			score
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, q.IP, q.From, q.Name, q.Subject, q.Body, q.Reason, q.Score)
+	if err != nil {
+		return fmt.Errorf("failed to exec: %w", err)
+	}
+
+	return nil
+}
+
+type rejection struct {
+	ID          int       `db:"id"`
+	IP          string    `db:"ip"`
+	SenderEmail string    `db:"sender_email"`
+	Name        string    `db:"name"`
+	Subject     string    `db:"subject"`
+	Body        string    `db:"body"`
+	Reason      string    `db:"reason"`
+	Score       int       `db:"score"`
+	CreatedAt   time.Time `db:"created_at"`
+	TotalCount  int       `db:"total_count"`
+}
+
+func (r repository) FindRejections(ctx context.Context, q domain.FindRejectionsQuery) (domain.FindRejectionsResult, error) {
+	var rows []rejection
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT
+			id,
+			ip,
+			sender_email,
+			name,
+			subject,
+			body,
+			reason,
+			score,
+			created_at,
+			COUNT(*) OVER() AS total_count
+		FROM public.contact_rejections
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+	`, q.Limit, (q.Page-1)*q.Limit)
+	if err != nil {
+		return domain.FindRejectionsResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindRejectionsResult{
+		Data: make([]domain.Rejection, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Total = row.TotalCount
+		res.Data = append(res.Data, domain.Rejection{
+			ID:        row.ID,
+			IP:        row.IP,
+			From:      row.SenderEmail,
+			Name:      row.Name,
+			Subject:   row.Subject,
+			Body:      row.Body,
+			Reason:    domain.Reason(row.Reason),
+			Score:     row.Score,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
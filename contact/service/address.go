@@ -0,0 +1,50 @@
+package service
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// validateAddress checks the syntax of a bare email address. The domain is
+// not looked up, a well formed domain that does not receive mail passes.
+func validateAddress(address string) error {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse: %w", err)
+	}
+
+	if parsed.Name != "" || parsed.Address != address {
+		return fmt.Errorf("not a bare address")
+	}
+
+	local, domain, _ := strings.Cut(parsed.Address, "@")
+
+	if len(local) > 64 || len(parsed.Address) > 254 {
+		return fmt.Errorf("too long")
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("domain has no top level domain")
+	}
+
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("invalid domain label %q", label)
+		}
+
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return fmt.Errorf("invalid domain label %q", label)
+			}
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	if len(tld) < 2 || strings.Trim(tld, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return fmt.Errorf("invalid top level domain %q", tld)
+	}
+
+	return nil
+}
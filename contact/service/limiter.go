@@ -0,0 +1,63 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// limiter allows limit hits per key within a fixed window. Counts are kept in
+// memory, so with several instances each one limits on its own.
+type limiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	hits   map[string]*window
+	pruned time.Time
+	now    func() time.Time
+}
+
+type window struct {
+	start time.Time
+	count int
+}
+
+func newLimiter(limit int, w time.Duration) *limiter {
+	return &limiter{
+		limit:  limit,
+		window: w,
+		hits:   make(map[string]*window),
+		now:    time.Now,
+	}
+}
+
+// allow counts a hit for key and reports whether it is within the limit, and
+// whether it is the first hit over the limit in the window of key.
+func (l *limiter) allow(key string) (bool, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	// Expired windows are dropped once per window, so the map does not grow
+	// with every IP that ever submitted and a flood does not scan it on every
+	// hit.
+	if now.Sub(l.pruned) >= l.window {
+		for k, w := range l.hits {
+			if now.Sub(w.start) >= l.window {
+				delete(l.hits, k)
+			}
+		}
+
+		l.pruned = now
+	}
+
+	w, ok := l.hits[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = &window{start: now}
+		l.hits[key] = w
+	}
+
+	w.count++
+
+	return w.count <= l.limit, w.count == l.limit+1
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	l := newLimiter(2, time.Minute)
+	l.now = func() time.Time { return now }
+
+	tests := []struct {
+		key       string
+		advance   time.Duration
+		wantAllow bool
+		wantFirst bool
+	}{
+		{key: "a", wantAllow: true},
+		{key: "a", wantAllow: true},
+		{key: "a", wantFirst: true},
+		{key: "a"},
+		// Other keys have their own window.
+		{key: "b", wantAllow: true},
+		{key: "a", advance: 30 * time.Second},
+		// The window of a has expired.
+		{key: "a", advance: 30 * time.Second, wantAllow: true},
+	}
+
+	for i, tt := range tests {
+		now = now.Add(tt.advance)
+
+		allowed, first := l.allow(tt.key)
+		if allowed != tt.wantAllow || first != tt.wantFirst {
+			t.Errorf("allow %d for %q = %t, %t, want %t, %t", i, tt.key, allowed, first, tt.wantAllow, tt.wantFirst)
+		}
+	}
+
+	// b expired with the last hit of a and was pruned with it.
+	if _, ok := l.hits["b"]; ok {
+		t.Errorf("expired window of %q was not pruned", "b")
+	}
+}
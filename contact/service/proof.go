@@ -0,0 +1,53 @@
+package service
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// verifyProof checks a proof of work for the sender. The client picks a
+// nonce so that sha256("<from>:<timestamp>:<nonce>") starts with difficulty
+// zero bits, from being the lowercased sender address. The timestamp must be
+// within maxAge of now.
+func verifyProof(proof, from string, difficulty int, maxAge time.Duration, now time.Time) error {
+	timestamp, nonce, ok := strings.Cut(proof, ":")
+	if !ok || nonce == "" {
+		return fmt.Errorf("malformed proof")
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed timestamp: %w", err)
+	}
+
+	age := now.Sub(time.Unix(unix, 0))
+	if age < -time.Minute || age > maxAge {
+		return fmt.Errorf("expired proof")
+	}
+
+	sum := sha256.Sum256([]byte(strings.ToLower(from) + ":" + timestamp + ":" + nonce))
+
+	if leadingZeroBits(sum[:]) < difficulty {
+		return fmt.Errorf("insufficient work")
+	}
+
+	return nil
+}
+
+func leadingZeroBits(b []byte) int {
+	n := 0
+
+	for _, c := range b {
+		if c != 0 {
+			return n + bits.LeadingZeros8(c)
+		}
+
+		n += 8
+	}
+
+	return n
+}
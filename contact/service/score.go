@@ -0,0 +1,65 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	linkPattern   = regexp.MustCompile(`(?i)https?://|www\.`)
+	markupPattern = regexp.MustCompile(`(?i)<a\s|\[url[=\]]`)
+
+	spamPhrases = []string{
+		"viagra", "casino", "crypto", "bitcoin", "forex", "backlink", "seo service",
+		"loan", "click here", "free money", "porn", "earn money", "guest post",
+	}
+)
+
+// score rates how spammy a submission looks, the higher the worse. It counts
+// links, link markup, known spam phrases and shouting.
+func score(subject, body string) int {
+	text := subject + "\n" + body
+	lower := strings.ToLower(text)
+
+	s := 0
+
+	links := len(linkPattern.FindAllStringIndex(text, -1))
+	s += 2 * links
+
+	if links > 3 {
+		s += 5
+	}
+
+	if markupPattern.MatchString(text) {
+		s += 4
+	}
+
+	for _, phrase := range spamPhrases {
+		if strings.Contains(lower, phrase) {
+			s += 3
+		}
+	}
+
+	var letters, upper int
+
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+
+	if letters >= 20 && upper*2 > letters {
+		s += 3
+	}
+
+	if len(strings.TrimSpace(body)) < 10 {
+		s += 2
+	}
+
+	return s
+}
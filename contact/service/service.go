@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/contact/domain"
+	notificationdomain "github.com/vediagames/platform/notification/domain"
+)
+
+type Config struct {
+	Repository          domain.Repository
+	NotificationService notificationdomain.Service
+	// Recipient receives accepted submissions.
+	Recipient notificationdomain.User
+	// IPLimit and SenderLimit are the submissions allowed per Window from one
+	// IP and one sender address.
+	IPLimit     int
+	SenderLimit int
+	Window      time.Duration
+	// ProofDifficulty is the number of leading zero bits a proof of work must
+	// have, 0 does not require a proof.
+	ProofDifficulty int
+	ProofMaxAge     time.Duration
+	// SpamThreshold is the content score from which a submission is spam.
+	SpamThreshold int
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Repository == nil, fmt.Errorf("empty repository"))
+	err.AddIf(c.NotificationService == nil, fmt.Errorf("empty notification service"))
+
+	if ve := c.Recipient.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid recipient: %w", ve))
+	}
+
+	err.AddIf(c.IPLimit < 1, fmt.Errorf("IP limit must be positive"))
+	err.AddIf(c.SenderLimit < 1, fmt.Errorf("sender limit must be positive"))
+	err.AddIf(c.Window <= 0, fmt.Errorf("window must be positive"))
+	err.AddIf(c.ProofDifficulty < 0 || c.ProofDifficulty > 32, fmt.Errorf("proof difficulty must be between 0 and 32"))
+	err.AddIf(c.ProofDifficulty > 0 && c.ProofMaxAge <= 0, fmt.Errorf("proof max age must be positive"))
+	err.AddIf(c.SpamThreshold < 1, fmt.Errorf("spam threshold must be positive"))
+
+	return err.Err()
+}
+
+type service struct {
+	repository          domain.Repository
+	notificationService notificationdomain.Service
+	recipient           notificationdomain.User
+	ipLimiter           *limiter
+	senderLimiter       *limiter
+	proofDifficulty     int
+	proofMaxAge         time.Duration
+	spamThreshold       int
+}
+
+func New(cfg Config) domain.Service {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &service{
+		repository:          cfg.Repository,
+		notificationService: cfg.NotificationService,
+		recipient:           cfg.Recipient,
+		ipLimiter:           newLimiter(cfg.IPLimit, cfg.Window),
+		senderLimiter:       newLimiter(cfg.SenderLimit, cfg.Window),
+		proofDifficulty:     cfg.ProofDifficulty,
+		proofMaxAge:         cfg.ProofMaxAge,
+		spamThreshold:       cfg.SpamThreshold,
+	}
+}
+
+// Submit emails the submission to the recipient unless it is rejected.
+// Rejected submissions are stored for review.
+func (s *service) Submit(ctx context.Context, req domain.SubmitRequest) (domain.SubmitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.SubmitResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	// Every attempt counts, so a flood is cut off before anything else runs.
+	ipAllowed, ipFirst := s.ipLimiter.allow(req.IP)
+	senderAllowed, senderFirst := s.senderLimiter.allow(strings.ToLower(req.From))
+
+	if !ipAllowed || !senderAllowed {
+		// Only the first attempt over a limit in a window is stored, the rest
+		// of a flood is logged and dropped.
+		if ipFirst || senderFirst {
+			return s.reject(ctx, req, domain.ReasonRateLimited, 0, nil)
+		}
+
+		logRejection(ctx, req, domain.ReasonRateLimited, 0, nil)
+
+		return domain.SubmitResponse{
+			Reason: domain.ReasonRateLimited,
+		}, nil
+	}
+
+	if req.Honeypot != "" {
+		return s.reject(ctx, req, domain.ReasonHoneypot, 0, nil)
+	}
+
+	if err := validateAddress(req.From); err != nil {
+		return s.reject(ctx, req, domain.ReasonInvalidEmail, 0, err)
+	}
+
+	if s.proofDifficulty > 0 {
+		if err := verifyProof(req.Proof, req.From, s.proofDifficulty, s.proofMaxAge, time.Now()); err != nil {
+			return s.reject(ctx, req, domain.ReasonInvalidProof, 0, err)
+		}
+	}
+
+	if sc := score(req.Subject, req.Body); sc >= s.spamThreshold {
+		return s.reject(ctx, req, domain.ReasonSpam, sc, nil)
+	}
+
+	err := s.notificationService.Send(ctx, notificationdomain.SendRequest{
+		To: s.recipient,
+		From: notificationdomain.User{
+			Email: req.From,
+			Name:  "platform Contact form",
+		},
+		Template: notificationdomain.TemplateContactForm,
+		Language: notificationdomain.LanguageEnglish,
+		Data: notificationdomain.ContactFormData{
+			Name:    req.Name,
+			Email:   req.From,
+			Subject: req.Subject,
+			Body:    req.Body,
+		},
+	})
+	if err != nil {
+		return domain.SubmitResponse{}, fmt.Errorf("failed to send: %w", err)
+	}
+
+	return domain.SubmitResponse{
+		Accepted: true,
+	}, nil
+}
+
+func (s *service) reject(ctx context.Context, req domain.SubmitRequest, reason domain.Reason, sc int, cause error) (domain.SubmitResponse, error) {
+	logRejection(ctx, req, reason, sc, cause)
+
+	if err := s.repository.InsertRejection(ctx, domain.InsertRejectionQuery{
+		IP:      req.IP,
+		From:    req.From,
+		Name:    req.Name,
+		Subject: req.Subject,
+		Body:    req.Body,
+		Reason:  reason,
+		Score:   sc,
+	}); err != nil {
+		return domain.SubmitResponse{}, fmt.Errorf("failed to insert rejection: %w", err)
+	}
+
+	return domain.SubmitResponse{
+		Reason: reason,
+	}, nil
+}
+
+func logRejection(ctx context.Context, req domain.SubmitRequest, reason domain.Reason, sc int, cause error) {
+	zerolog.Ctx(ctx).Warn().
+		Err(cause).
+		Str("ip", req.IP).
+		Str("from", req.From).
+		Str("reason", reason.String()).
+		Int("score", sc).
+		Msg("rejected contact submission")
+}
+
+func (s *service) ListRejections(ctx context.Context, req domain.ListRejectionsRequest) (domain.ListRejectionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.ListRejectionsResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	repoRes, err := s.repository.FindRejections(ctx, domain.FindRejectionsQuery(req))
+	if err != nil {
+		return domain.ListRejectionsResponse{}, fmt.Errorf("failed to find: %w", err)
+	}
+
+	res := domain.ListRejectionsResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.ListRejectionsResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
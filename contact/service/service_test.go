@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/vediagames/platform/contact/domain"
+	notificationdomain "github.com/vediagames/platform/notification/domain"
+)
+
+type fakeRepository struct {
+	domain.Repository
+	rejections []domain.InsertRejectionQuery
+}
+
+func (r *fakeRepository) InsertRejection(_ context.Context, q domain.InsertRejectionQuery) error {
+	r.rejections = append(r.rejections, q)
+	return nil
+}
+
+type fakeNotificationService struct {
+	sent []notificationdomain.SendRequest
+}
+
+func (s *fakeNotificationService) Send(_ context.Context, req notificationdomain.SendRequest) error {
+	s.sent = append(s.sent, req)
+	return nil
+}
+
+func newTestService(difficulty int) (domain.Service, *fakeRepository, *fakeNotificationService) {
+	repo := &fakeRepository{}
+	notifications := &fakeNotificationService{}
+
+	s := New(Config{
+		Repository:          repo,
+		NotificationService: notifications,
+		Recipient:           notificationdomain.User{Email: "editor@example.com", Name: "Editor"},
+		IPLimit:             3,
+		SenderLimit:         2,
+		Window:              time.Hour,
+		ProofDifficulty:     difficulty,
+		ProofMaxAge:         10 * time.Minute,
+		SpamThreshold:       5,
+	})
+
+	return s, repo, notifications
+}
+
+// solve finds a proof of work the way a client would.
+func solve(from string, difficulty int) string {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	for nonce := 0; ; nonce++ {
+		n := strconv.Itoa(nonce)
+		sum := sha256.Sum256([]byte(from + ":" + timestamp + ":" + n))
+
+		if leadingZeroBits(sum[:]) >= difficulty {
+			return timestamp + ":" + n
+		}
+	}
+}
+
+func validRequest() domain.SubmitRequest {
+	return domain.SubmitRequest{
+		IP:      "203.0.113.1",
+		From:    "ann@example.com",
+		Name:    "Ann",
+		Subject: "Broken game",
+		Body:    "The racing game does not load on my phone.",
+	}
+}
+
+func TestService_Submit(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty int
+		modify     func(*domain.SubmitRequest)
+		wantReason domain.Reason
+	}{
+		{
+			name:   "accepted",
+			modify: func(*domain.SubmitRequest) {},
+		},
+		{
+			name: "honeypot",
+			modify: func(r *domain.SubmitRequest) {
+				r.Honeypot = "https://example.com"
+			},
+			wantReason: domain.ReasonHoneypot,
+		},
+		{
+			name: "invalid email",
+			modify: func(r *domain.SubmitRequest) {
+				r.From = "ann@localhost"
+			},
+			wantReason: domain.ReasonInvalidEmail,
+		},
+		{
+			name:       "missing proof",
+			difficulty: 8,
+			modify:     func(*domain.SubmitRequest) {},
+			wantReason: domain.ReasonInvalidProof,
+		},
+		{
+			name:       "valid proof",
+			difficulty: 8,
+			modify: func(r *domain.SubmitRequest) {
+				r.Proof = solve(r.From, 8)
+			},
+		},
+		{
+			name: "spam",
+			modify: func(r *domain.SubmitRequest) {
+				r.Subject = "CHEAP CASINO BONUS"
+				r.Body = "CLICK HERE https://a.example https://b.example"
+			},
+			wantReason: domain.ReasonSpam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo, notifications := newTestService(tt.difficulty)
+
+			req := validRequest()
+			tt.modify(&req)
+
+			res, err := s.Submit(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Submit() error = %v", err)
+			}
+
+			if res.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", res.Reason, tt.wantReason)
+			}
+
+			if tt.wantReason == "" {
+				if !res.Accepted || len(notifications.sent) != 1 || len(repo.rejections) != 0 {
+					t.Errorf("accepted = %t, sent = %d, rejections = %d, want sent only",
+						res.Accepted, len(notifications.sent), len(repo.rejections))
+				}
+
+				return
+			}
+
+			if res.Accepted || len(notifications.sent) != 0 || len(repo.rejections) != 1 {
+				t.Errorf("accepted = %t, sent = %d, rejections = %d, want stored only",
+					res.Accepted, len(notifications.sent), len(repo.rejections))
+			}
+		})
+	}
+}
+
+func TestService_SubmitRateLimit(t *testing.T) {
+	s, repo, notifications := newTestService(0)
+
+	// The sender limit of 2 is reached first.
+	for i, want := range []domain.Reason{"", "", domain.ReasonRateLimited} {
+		res, err := s.Submit(context.Background(), validRequest())
+		if err != nil {
+			t.Fatalf("Submit() %d error = %v", i, err)
+		}
+
+		if res.Reason != want {
+			t.Errorf("Submit() %d reason = %q, want %q", i, res.Reason, want)
+		}
+	}
+
+	// The first attempt over each limit is stored, the ones after it are not.
+	for i, want := range []int{2, 2} {
+		res, err := s.Submit(context.Background(), validRequest())
+		if err != nil {
+			t.Fatalf("Submit() %d error = %v", i, err)
+		}
+
+		if res.Reason != domain.ReasonRateLimited || len(repo.rejections) != want {
+			t.Errorf("Submit() %d reason = %q, rejections = %d, want %q and %d",
+				i, res.Reason, len(repo.rejections), domain.ReasonRateLimited, want)
+		}
+	}
+
+	// The IP limit of 3 is counted across senders.
+	req := validRequest()
+	req.From = "bob@example.com"
+
+	res, err := s.Submit(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	if res.Reason != domain.ReasonRateLimited {
+		t.Errorf("reason = %q, want %q", res.Reason, domain.ReasonRateLimited)
+	}
+
+	req.IP = "203.0.113.2"
+
+	res, err = s.Submit(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	if !res.Accepted {
+		t.Errorf("submission from another IP was rejected: %q", res.Reason)
+	}
+
+	if len(notifications.sent) != 3 || len(repo.rejections) != 2 {
+		t.Errorf("sent = %d, rejections = %d, want 3 and 2", len(notifications.sent), len(repo.rejections))
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	for address, valid := range map[string]bool{
+		"ann@example.com":          true,
+		"ann.lee+games@mail.co.uk": true,
+		"Ann <ann@example.com>":    false,
+		"ann@localhost":            false,
+		"ann@-example.com":         false,
+		"ann@example.c0m":          false,
+		"ann@example..com":         false,
+		"ann example@example.com":  false,
+		"":                         false,
+	} {
+		if err := validateAddress(address); (err == nil) != valid {
+			t.Errorf("validateAddress(%q) error = %v, want valid %t", address, err, valid)
+		}
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	now := time.Now()
+	proof := solve("ann@example.com", 16)
+
+	if err := verifyProof(proof, "Ann@Example.com", 16, time.Minute, now); err != nil {
+		t.Errorf("verifyProof() error = %v, want nil", err)
+	}
+
+	if err := verifyProof(proof, "bob@example.com", 16, time.Minute, now); err == nil {
+		t.Errorf("verifyProof() for another sender error = nil")
+	}
+
+	if err := verifyProof(proof, "ann@example.com", 16, time.Minute, now.Add(2*time.Minute)); err == nil {
+		t.Errorf("verifyProof() for expired proof error = nil")
+	}
+}
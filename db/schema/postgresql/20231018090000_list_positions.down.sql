@@ -0,0 +1,13 @@
+BEGIN;
+
+DELETE FROM public.list_games WHERE list_slug IN ('promoted', 'picked-by-editor', 'trending', 'popular');
+DELETE FROM public.lists WHERE slug IN ('promoted', 'picked-by-editor', 'trending', 'popular');
+
+ALTER TABLE public.list_games
+    DROP CONSTRAINT list_games_pkey,
+    ALTER COLUMN list_slug DROP NOT NULL,
+    ALTER COLUMN game_slug DROP NOT NULL,
+    ALTER COLUMN inserted_at DROP NOT NULL,
+    DROP COLUMN position;
+
+COMMIT;
@@ -0,0 +1,36 @@
+BEGIN;
+
+ALTER TABLE public.list_games
+    ADD COLUMN position INTEGER NOT NULL DEFAULT 0,
+    ALTER COLUMN list_slug SET NOT NULL,
+    ALTER COLUMN game_slug SET NOT NULL,
+    ALTER COLUMN inserted_at SET NOT NULL,
+    ADD PRIMARY KEY (list_slug, game_slug);
+
+INSERT INTO public.lists (slug, name, description)
+VALUES ('promoted', 'Promoted', 'Game promoted on the home page.'),
+       ('picked-by-editor', 'Picked by editor', 'Game picked by the editor.'),
+       ('trending', 'Trending', 'Trending games shown on the home page.'),
+       ('popular', 'Popular', 'Popular games shown on the home page.');
+
+INSERT INTO public.list_games (list_slug, game_slug, label, description, position)
+SELECT v.list_slug, v.game_slug, NULLIF(v.label, ''), NULLIF(v.description, ''), v.position
+FROM (VALUES ('promoted', 'time-shooter-3-swat', 'SLOW MO 🕰️🔫', 'Shoot the bad guys in slow motion.', 0),
+             ('picked-by-editor', 'mx-offroad-master', 'SPORT', '', 0),
+             ('trending', 'stick-man', '', '', 0),
+             ('trending', 'basketball-legends', 'CLASSIC', '', 1),
+             ('trending', 'kirka-io', 'FPS', '', 2),
+             ('trending', 'god-simulator', 'PLAY GOD', '', 3),
+             ('trending', 'hole-io', '', '', 4),
+             ('trending', 'minegame', '', '', 5),
+             ('trending', 'smartphone-tycoon', 'TYCOON', '', 6),
+             ('trending', 'the-mergest-kingdom', 'MERGE', '', 7),
+             ('trending', 'beauty-run-run', '', '', 8),
+             ('popular', 'skribbl-io', '', '', 0),
+             ('popular', 'football-legends-2021', '', '', 1),
+             ('popular', 'paperio-2', '', '', 2),
+             ('popular', 'soccer-masters', '', '', 3)
+     ) AS v(list_slug, game_slug, label, description, position)
+WHERE EXISTS (SELECT 1 FROM public.games AS g WHERE g.slug = v.game_slug);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.game_event_outbox;
+
+COMMIT;
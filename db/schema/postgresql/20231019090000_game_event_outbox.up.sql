@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.game_event_outbox (
+    id              BIGSERIAL PRIMARY KEY,
+    game_id         INT          NOT NULL REFERENCES public.games (id) ON DELETE CASCADE,
+    event           VARCHAR(16)  NOT NULL,
+    logged_at       TIMESTAMP    NOT NULL,
+    attempts        INT          NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP    NOT NULL DEFAULT now(),
+    last_error      TEXT,
+    failed_at       TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS game_event_outbox_pending_idx
+    ON public.game_event_outbox (next_attempt_at)
+    WHERE failed_at IS NULL;
+
+COMMIT;
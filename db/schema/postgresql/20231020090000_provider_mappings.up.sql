@@ -0,0 +1,14 @@
+BEGIN;
+
+-- Maps category and tag names used by game providers to our tags and
+-- categories. Names without a mapping fall back to a tag or category with
+-- the slugified name.
+CREATE TABLE IF NOT EXISTS public.provider_mappings (
+    provider    TEXT NOT NULL,
+    kind        TEXT NOT NULL CHECK (kind IN ('tag', 'category')),
+    external    TEXT NOT NULL,
+    internal_id INT  NOT NULL,
+    PRIMARY KEY (provider, kind, external)
+);
+
+COMMIT;
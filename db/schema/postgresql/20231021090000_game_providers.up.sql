@@ -0,0 +1,14 @@
+BEGIN;
+
+-- Links games to the provider catalog entry they were imported from, so the
+-- sync job can find upstream changes and removals.
+CREATE TABLE IF NOT EXISTS public.game_providers (
+    game_id       INT         NOT NULL PRIMARY KEY REFERENCES public.games (id) ON DELETE CASCADE,
+    provider      TEXT        NOT NULL,
+    provider_id   TEXT        NOT NULL,
+    synced_at     TIMESTAMPTZ,
+    missing_since TIMESTAMPTZ,
+    UNIQUE (provider, provider_id)
+);
+
+COMMIT;
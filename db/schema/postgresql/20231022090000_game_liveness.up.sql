@@ -0,0 +1,20 @@
+BEGIN;
+
+-- Last liveness check of each published game URL.
+CREATE TABLE IF NOT EXISTS public.game_liveness (
+    game_id              INT         NOT NULL PRIMARY KEY REFERENCES public.games (id) ON DELETE CASCADE,
+    url                  TEXT        NOT NULL,
+    status_code          INT         NOT NULL DEFAULT 0,
+    latency_ms           INT         NOT NULL DEFAULT 0,
+    error                TEXT        NOT NULL DEFAULT '',
+    checked_at           TIMESTAMPTZ NOT NULL,
+    last_success_at      TIMESTAMPTZ,
+    consecutive_failures INT         NOT NULL DEFAULT 0,
+    disabled_at          TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS game_liveness_failing_idx
+    ON public.game_liveness (consecutive_failures DESC, checked_at DESC)
+    WHERE consecutive_failures > 0;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+-- Contact form submissions rejected by the spam filter, kept for review.
+CREATE TABLE IF NOT EXISTS public.contact_rejections (
+    id           SERIAL      NOT NULL PRIMARY KEY,
+    ip           TEXT        NOT NULL,
+    sender_email TEXT        NOT NULL,
+    name         TEXT        NOT NULL,
+    subject      TEXT        NOT NULL,
+    body         TEXT        NOT NULL,
+    reason       TEXT        NOT NULL,
+    score        INT         NOT NULL DEFAULT 0,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS contact_rejections_created_at_idx
+    ON public.contact_rejections (created_at DESC);
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- Current reaction of each visitor to a game. Visitors are "user:<id>" or
+-- "session:<id>". games.likes and games.dislikes move along with this table.
+CREATE TABLE IF NOT EXISTS public.game_reactions (
+    game_id    INT         NOT NULL REFERENCES public.games (id) ON DELETE CASCADE,
+    visitor    TEXT        NOT NULL,
+    reaction   TEXT        NOT NULL CHECK (reaction IN ('like', 'dislike')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, visitor)
+);
+
+CREATE INDEX IF NOT EXISTS game_reactions_visitor_idx
+    ON public.game_reactions (visitor);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.game_play_history;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Games played by each visitor. Visitors are "user:<id>" or "session:<id>".
+CREATE TABLE IF NOT EXISTS public.game_play_history (
+    game_id         INT         NOT NULL REFERENCES public.games (id) ON DELETE CASCADE,
+    visitor         TEXT        NOT NULL,
+    plays           INT         NOT NULL DEFAULT 1,
+    first_played_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_played_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, visitor)
+);
+
+CREATE INDEX IF NOT EXISTS game_play_history_visitor_last_played_at_idx
+    ON public.game_play_history (visitor, last_played_at DESC);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.game_play_events_date_idx;
+DROP TABLE IF EXISTS public.game_trending_scores;
+
+COMMIT;
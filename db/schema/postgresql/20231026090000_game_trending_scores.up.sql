@@ -0,0 +1,14 @@
+BEGIN;
+
+-- Snapshot of trending games, replaced as a whole on every computation.
+CREATE TABLE IF NOT EXISTS public.game_trending_scores (
+    game_id     INT              NOT NULL PRIMARY KEY REFERENCES public.games (id) ON DELETE CASCADE,
+    score       DOUBLE PRECISION NOT NULL,
+    plays       INT              NOT NULL,
+    computed_at TIMESTAMPTZ      NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS game_play_events_date_idx
+    ON public.game_play_events (date);
+
+COMMIT;
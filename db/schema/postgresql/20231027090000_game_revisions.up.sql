@@ -0,0 +1,15 @@
+BEGIN;
+
+-- Editorial state of a game after every change, numbered per game.
+CREATE TABLE IF NOT EXISTS public.game_revisions (
+    game_id        INT         NOT NULL REFERENCES public.games (id) ON DELETE CASCADE,
+    number         INT         NOT NULL,
+    action         TEXT        NOT NULL,
+    author         TEXT        NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    changed_fields TEXT[]      NOT NULL DEFAULT '{}',
+    snapshot       JSONB       NOT NULL,
+    PRIMARY KEY (game_id, number)
+);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Enum values cannot be dropped, games waiting for their schedule become
+-- invisible instead.
+UPDATE public.games SET status = 'invisible' WHERE status = 'scheduled';
+
+DROP TABLE IF EXISTS public.game_schedules;
+
+COMMIT;
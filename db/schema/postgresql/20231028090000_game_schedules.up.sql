@@ -0,0 +1,17 @@
+-- Adding an enum value cannot run inside a transaction block before
+-- PostgreSQL 12.
+ALTER TYPE status ADD VALUE IF NOT EXISTS 'scheduled';
+
+BEGIN;
+
+-- When games go live and when they are taken down again. Rows are removed by
+-- the scheduler once nothing is left to apply.
+CREATE TABLE IF NOT EXISTS public.game_schedules (
+    game_id      INT         NOT NULL PRIMARY KEY REFERENCES public.games (id) ON DELETE CASCADE,
+    publish_at   TIMESTAMPTZ,
+    unpublish_at TIMESTAMPTZ,
+    CHECK (publish_at IS NOT NULL OR unpublish_at IS NOT NULL),
+    CHECK (unpublish_at > publish_at)
+);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.category_slug_history;
+DROP TABLE IF EXISTS public.tag_slug_history;
+DROP TABLE IF EXISTS public.game_slug_history;
+
+COMMIT;
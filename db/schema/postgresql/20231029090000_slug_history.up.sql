@@ -0,0 +1,23 @@
+BEGIN;
+
+-- Slugs games, tags and categories were known by before a rename. A slug is
+-- removed from the history once an entity takes it as its current slug.
+CREATE TABLE IF NOT EXISTS public.game_slug_history (
+    slug        TEXT        NOT NULL PRIMARY KEY,
+    game_id     INT         NOT NULL REFERENCES public.games (id) ON DELETE CASCADE,
+    replaced_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS public.tag_slug_history (
+    slug        TEXT        NOT NULL PRIMARY KEY,
+    tag_id      INT         NOT NULL REFERENCES public.tags (id) ON DELETE CASCADE,
+    replaced_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS public.category_slug_history (
+    slug        TEXT        NOT NULL PRIMARY KEY,
+    category_id INT         NOT NULL REFERENCES public.categories (id) ON DELETE CASCADE,
+    replaced_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
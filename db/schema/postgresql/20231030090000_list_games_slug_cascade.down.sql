@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE public.list_games
+    DROP CONSTRAINT list_games_game_slug_fkey,
+    ADD CONSTRAINT list_games_game_slug_fkey
+        FOREIGN KEY (game_slug) REFERENCES public.games (slug);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Renaming a game carries its slug over to the lists it is in.
+ALTER TABLE public.list_games
+    DROP CONSTRAINT list_games_game_slug_fkey,
+    ADD CONSTRAINT list_games_game_slug_fkey
+        FOREIGN KEY (game_slug) REFERENCES public.games (slug) ON UPDATE CASCADE;
+
+COMMIT;
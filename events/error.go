@@ -0,0 +1,14 @@
+package events
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrEmptyID           = Error("empty id")
+	ErrInvalidType       = Error("invalid type")
+	ErrInvalidOccurredAt = Error("invalid occurred at")
+	ErrInvalidPayload    = Error("invalid payload")
+)
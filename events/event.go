@@ -0,0 +1,204 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vediagames/zeroerror"
+)
+
+// Publisher publishes domain events. Implementations must be safe for
+// concurrent use.
+type Publisher interface {
+	Publish(context.Context, ...Event) error
+}
+
+// Event mirrors the Event message in db/schema/pubsub/platform.proto and
+// marshals to its proto3 JSON encoding.
+type Event struct {
+	ID              string       `json:"id"`
+	Type            Type         `json:"type"`
+	OccurredAt      string       `json:"occurredAt"`
+	GameCreated     *Game        `json:"gameCreated,omitempty"`
+	GameUpdated     *Game        `json:"gameUpdated,omitempty"`
+	GameDeleted     *GameRef     `json:"gameDeleted,omitempty"`
+	GamePlayed      *GameRef     `json:"gamePlayed,omitempty"`
+	GameLiked       *GameRef     `json:"gameLiked,omitempty"`
+	GameDisliked    *GameRef     `json:"gameDisliked,omitempty"`
+	TagClicked      *TagRef      `json:"tagClicked,omitempty"`
+	CategoryClicked *CategoryRef `json:"categoryClicked,omitempty"`
+}
+
+func (e Event) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(e.ID == "", ErrEmptyID)
+
+	if ve := e.Type.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidType, ve))
+	}
+
+	if _, pe := time.Parse(time.RFC3339Nano, e.OccurredAt); pe != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidOccurredAt, pe))
+	}
+
+	if e.payloads() != 1 || !e.hasPayload() {
+		err.Add(fmt.Errorf("%w for type %s", ErrInvalidPayload, e.Type))
+	}
+
+	return err.Err()
+}
+
+func (e Event) payloads() int {
+	set := []bool{
+		e.GameCreated != nil,
+		e.GameUpdated != nil,
+		e.GameDeleted != nil,
+		e.GamePlayed != nil,
+		e.GameLiked != nil,
+		e.GameDisliked != nil,
+		e.TagClicked != nil,
+		e.CategoryClicked != nil,
+	}
+
+	n := 0
+	for _, ok := range set {
+		if ok {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (e Event) hasPayload() bool {
+	switch e.Type {
+	case TypeGameCreated:
+		return e.GameCreated != nil
+	case TypeGameUpdated:
+		return e.GameUpdated != nil
+	case TypeGameDeleted:
+		return e.GameDeleted != nil
+	case TypeGamePlayed:
+		return e.GamePlayed != nil
+	case TypeGameLiked:
+		return e.GameLiked != nil
+	case TypeGameDisliked:
+		return e.GameDisliked != nil
+	case TypeTagClicked:
+		return e.TagClicked != nil
+	case TypeCategoryClicked:
+		return e.CategoryClicked != nil
+	}
+
+	return false
+}
+
+type Type string
+
+func (t Type) Validate() error {
+	switch t {
+	case TypeGameCreated, TypeGameUpdated, TypeGameDeleted,
+		TypeGamePlayed, TypeGameLiked, TypeGameDisliked,
+		TypeTagClicked, TypeCategoryClicked:
+		return nil
+	}
+
+	return fmt.Errorf("type %q is not supported", t)
+}
+
+func (t Type) String() string {
+	return string(t)
+}
+
+const (
+	TypeGameCreated     Type = "TYPE_GAME_CREATED"
+	TypeGameUpdated     Type = "TYPE_GAME_UPDATED"
+	TypeGameDeleted     Type = "TYPE_GAME_DELETED"
+	TypeGamePlayed      Type = "TYPE_GAME_PLAYED"
+	TypeGameLiked       Type = "TYPE_GAME_LIKED"
+	TypeGameDisliked    Type = "TYPE_GAME_DISLIKED"
+	TypeTagClicked      Type = "TYPE_TAG_CLICKED"
+	TypeCategoryClicked Type = "TYPE_CATEGORY_CLICKED"
+)
+
+type Game struct {
+	ID          int    `json:"id"`
+	Slug        string `json:"slug"`
+	Status      string `json:"status"`
+	Mobile      bool   `json:"mobile"`
+	URL         string `json:"url"`
+	TagIDs      []int  `json:"tagIds"`
+	CategoryIDs []int  `json:"categoryIds"`
+}
+
+type GameRef struct {
+	ID   int    `json:"id"`
+	Slug string `json:"slug,omitempty"`
+}
+
+type TagRef struct {
+	ID int `json:"id"`
+}
+
+type CategoryRef struct {
+	ID int `json:"id"`
+}
+
+func newEvent(t Type) Event {
+	return Event{
+		ID:         uuid.NewString(),
+		Type:       t,
+		OccurredAt: time.Now().UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func GameCreated(game Game) Event {
+	e := newEvent(TypeGameCreated)
+	e.GameCreated = &game
+	return e
+}
+
+func GameUpdated(game Game) Event {
+	e := newEvent(TypeGameUpdated)
+	e.GameUpdated = &game
+	return e
+}
+
+func GameDeleted(ref GameRef) Event {
+	e := newEvent(TypeGameDeleted)
+	e.GameDeleted = &ref
+	return e
+}
+
+func GamePlayed(ref GameRef) Event {
+	e := newEvent(TypeGamePlayed)
+	e.GamePlayed = &ref
+	return e
+}
+
+func GameLiked(ref GameRef) Event {
+	e := newEvent(TypeGameLiked)
+	e.GameLiked = &ref
+	return e
+}
+
+func GameDisliked(ref GameRef) Event {
+	e := newEvent(TypeGameDisliked)
+	e.GameDisliked = &ref
+	return e
+}
+
+func TagClicked(ref TagRef) Event {
+	e := newEvent(TypeTagClicked)
+	e.TagClicked = &ref
+	return e
+}
+
+func CategoryClicked(ref CategoryRef) Event {
+	e := newEvent(TypeCategoryClicked)
+	e.CategoryClicked = &ref
+	return e
+}
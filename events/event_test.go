@@ -0,0 +1,68 @@
+package events
+
+import (
+	"testing"
+)
+
+func TestEvent_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   func() Event
+		wantErr bool
+	}{
+		{
+			name: "game created",
+			event: func() Event {
+				return GameCreated(Game{ID: 1, Slug: "slug"})
+			},
+		},
+		{
+			name: "tag clicked",
+			event: func() Event {
+				return TagClicked(TagRef{ID: 1})
+			},
+		},
+		{
+			name: "payload does not match type",
+			event: func() Event {
+				e := GamePlayed(GameRef{ID: 1})
+				e.Type = TypeGameLiked
+				return e
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple payloads",
+			event: func() Event {
+				e := GameLiked(GameRef{ID: 1})
+				e.GameDisliked = e.GameLiked
+				return e
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown type",
+			event: func() Event {
+				e := CategoryClicked(CategoryRef{ID: 1})
+				e.Type = "TYPE_UNSPECIFIED"
+				return e
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty",
+			event: func() Event {
+				return Event{}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.event().Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
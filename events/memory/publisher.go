@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vediagames/platform/events"
+)
+
+// Publisher keeps published events in memory. It is meant for tests and
+// local runs without a Pub/Sub emulator.
+type Publisher struct {
+	mu     sync.Mutex
+	events []events.Event
+}
+
+func New() *Publisher {
+	return &Publisher{}
+}
+
+func (p *Publisher) Publish(_ context.Context, evs ...events.Event) error {
+	for i, e := range evs {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid event at index %d: %w", i, err)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, evs...)
+
+	return nil
+}
+
+// Events returns a copy of all published events in publishing order.
+func (p *Publisher) Events() []events.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := make([]events.Event, len(p.events))
+	copy(res, p.events)
+
+	return res
+}
+
+// Reset drops all published events.
+func (p *Publisher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = nil
+}
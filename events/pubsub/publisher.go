@@ -0,0 +1,100 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+	"google.golang.org/api/option"
+	pubsubapi "google.golang.org/api/pubsub/v1"
+
+	"github.com/vediagames/platform/events"
+)
+
+type Config struct {
+	ProjectID string
+	TopicID   string
+	// CredentialsPath is ignored when EmulatorHost is set.
+	CredentialsPath string
+	// EmulatorHost is the host:port of a Pub/Sub emulator, e.g. localhost:8085.
+	EmulatorHost string
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.ProjectID == "", fmt.Errorf("empty project ID"))
+	err.AddIf(c.TopicID == "", fmt.Errorf("empty topic ID"))
+	err.AddIf(c.EmulatorHost == "" && c.CredentialsPath == "", fmt.Errorf("empty credentials path"))
+
+	return err.Err()
+}
+
+type publisher struct {
+	service *pubsubapi.Service
+	topic   string
+}
+
+func New(ctx context.Context, cfg Config) events.Publisher {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	opts := []option.ClientOption{
+		option.WithCredentialsFile(cfg.CredentialsPath),
+	}
+
+	if cfg.EmulatorHost != "" {
+		opts = []option.ClientOption{
+			option.WithEndpoint("http://" + cfg.EmulatorHost + "/"),
+			option.WithoutAuthentication(),
+		}
+	}
+
+	service, err := pubsubapi.NewService(ctx, opts...)
+	if err != nil {
+		panic(fmt.Errorf("failed to create service: %w", err))
+	}
+
+	return &publisher{
+		service: service,
+		topic:   fmt.Sprintf("projects/%s/topics/%s", cfg.ProjectID, cfg.TopicID),
+	}
+}
+
+func (p publisher) Publish(ctx context.Context, evs ...events.Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+
+	messages := make([]*pubsubapi.PubsubMessage, 0, len(evs))
+
+	for i, e := range evs {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid event at index %d: %w", i, err)
+		}
+
+		data, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event at index %d: %w", i, err)
+		}
+
+		messages = append(messages, &pubsubapi.PubsubMessage{
+			Data: base64.StdEncoding.EncodeToString(data),
+			Attributes: map[string]string{
+				"type": e.Type.String(),
+			},
+		})
+	}
+
+	_, err := p.service.Projects.Topics.Publish(p.topic, &pubsubapi.PublishRequest{
+		Messages: messages,
+	}).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to publish: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package fetcher
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 
@@ -8,11 +9,13 @@ import (
 )
 
 type client struct {
-	clients []domain.Client
+	clients   map[string]domain.Client
+	providers []string
 }
 
 type Config struct {
-	Clients []domain.Client
+	// Clients maps provider names to their clients.
+	Clients map[string]domain.Client
 }
 
 func (c Config) Validate() error {
@@ -28,18 +31,38 @@ func New(cfg Config) domain.Client {
 		panic(fmt.Errorf("invalid config: %w", err))
 	}
 
+	providers := make([]string, 0, len(cfg.Clients))
+	for provider := range cfg.Clients {
+		providers = append(providers, provider)
+	}
+
 	return &client{
-		clients: cfg.Clients,
+		clients:   cfg.Clients,
+		providers: providers,
 	}
 }
 
-func (c client) Fetch() (domain.FetchedGame, error) {
-	client := c.clients[rand.Intn(len(c.clients))]
+func (c client) Fetch(ctx context.Context) (domain.FetchedGame, error) {
+	client := c.clients[c.providers[rand.Intn(len(c.providers))]]
 
-	game, err := client.Fetch()
+	game, err := client.Fetch(ctx)
 	if err != nil {
-		return domain.FetchedGame{}, fmt.Errorf("error fetching game: %v", err)
+		return domain.FetchedGame{}, fmt.Errorf("error fetching game: %w", err)
 	}
 
 	return game, nil
 }
+
+func (c client) List(ctx context.Context, req domain.ListRequest) (domain.ListResponse, error) {
+	client, ok := c.clients[req.Provider]
+	if !ok {
+		return domain.ListResponse{}, fmt.Errorf("%w: %q", domain.ErrUnknownProvider, req.Provider)
+	}
+
+	res, err := client.List(ctx, req)
+	if err != nil {
+		return domain.ListResponse{}, fmt.Errorf("failed to list %s games: %w", req.Provider, err)
+	}
+
+	return res, nil
+}
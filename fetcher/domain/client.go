@@ -1,7 +1,15 @@
 package domain
 
+import (
+	"context"
+	"strings"
+
+	"github.com/vediagames/zeroerror"
+)
+
 type Client interface {
-	Fetch() (FetchedGame, error)
+	Fetch(context.Context) (FetchedGame, error)
+	List(context.Context, ListRequest) (ListResponse, error)
 }
 
 type FetchedGame struct {
@@ -16,4 +24,95 @@ type FetchedGame struct {
 	Tags        []string
 	Images      []string
 	Slug        string
+	Provider    string
+	// ProviderID identifies the game at the provider and does not change
+	// when the provider renames it.
+	ProviderID string
+}
+
+type ListRequest struct {
+	// Provider picks the provider when listing through the fetcher that
+	// combines several of them. Single provider clients ignore it.
+	Provider   string
+	Page       int
+	Limit      int
+	Category   string
+	MobileOnly bool
+	// Query filters by name and description. The providers cannot search,
+	// so only the fetched page is filtered, see ListResponse.PageFiltered.
+	Query string
+}
+
+func (r ListRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Page < 1, ErrInvalidPage)
+	err.AddIf(r.Limit < 1 || r.Limit > 100, ErrInvalidLimit)
+
+	return err.Err()
+}
+
+type ListResponse struct {
+	Data []FetchedGame
+	// Total is reported by the provider when it can, otherwise it is
+	// estimated and only exact once the last page has been listed. It is 0
+	// when PageFiltered is set, the number of matches is not known then.
+	Total int
+	// PageFiltered is set when Query only filtered the fetched page, further
+	// pages may hold more matches.
+	PageFiltered bool
+	// HasNextPage reports whether the provider has games after this page,
+	// matching Query or not.
+	HasNextPage bool
+}
+
+// Response builds the response of a provider that cannot search from the
+// games it returned for the page, see EstimateTotal for known.
+func (r ListRequest) Response(games []FetchedGame, known int) ListResponse {
+	res := ListResponse{
+		Data:         make([]FetchedGame, 0, len(games)),
+		PageFiltered: r.Query != "",
+		HasNextPage:  len(games) == r.Limit,
+	}
+
+	if !res.PageFiltered {
+		res.Total = r.EstimateTotal(len(games), known)
+	}
+
+	for _, g := range games {
+		if g.Matches(r.Query) {
+			res.Data = append(res.Data, g)
+		}
+	}
+
+	return res
+}
+
+// EstimateTotal is for providers that do not report a total. n is the number
+// of games the provider returned for the page and known the catalog size
+// without filters, or 0 when it is unknown.
+func (r ListRequest) EstimateTotal(n, known int) int {
+	listed := (r.Page-1)*r.Limit + n
+
+	switch {
+	case n < r.Limit:
+		return listed
+	case known > listed && r.Category == "" && !r.MobileOnly:
+		return known
+	}
+
+	return listed + 1
+}
+
+// Matches reports whether the name or description contains the query,
+// ignoring case. An empty query matches every game.
+func (g FetchedGame) Matches(query string) bool {
+	if query == "" {
+		return true
+	}
+
+	query = strings.ToLower(query)
+
+	return strings.Contains(strings.ToLower(g.Name), query) ||
+		strings.Contains(strings.ToLower(g.Description), query)
 }
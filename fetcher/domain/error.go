@@ -7,5 +7,8 @@ func (e Error) Error() string {
 }
 
 const (
-	ErrNoData = Error("no data")
+	ErrNoData          = Error("no data")
+	ErrInvalidPage     = Error("invalid page")
+	ErrInvalidLimit    = Error("invalid limit")
+	ErrUnknownProvider = Error("unknown provider")
 )
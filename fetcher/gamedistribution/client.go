@@ -1,16 +1,26 @@
 package gamedistribution
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/gosimple/slug"
+	"github.com/vediagames/zeroerror"
 
 	"github.com/vediagames/platform/fetcher/domain"
 )
 
+const (
+	Provider   = "gamedistribution"
+	DefaultURL = "https://catalog.api.gamedistribution.com/api/v2.0/rss/All/"
+)
+
+// amountOfGames is the catalog size, the API does not report it.
 const amountOfGames = 14999
 
 type game struct {
@@ -50,45 +60,127 @@ func (g game) domain() domain.FetchedGame {
 		Tags:        g.Tag,
 		Images:      g.Asset,
 		Slug:        slug,
+		Provider:    Provider,
+		ProviderID:  g.Md5,
 	}
 }
 
-type client struct {
+type Config struct {
+	URL    string
+	Client *http.Client
+	// Limit is the page size Fetch picks a random game from.
 	Limit int
 }
 
-func New(limit int) domain.Client {
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.URL == "", fmt.Errorf("empty URL"))
+	err.AddIf(c.Client == nil, fmt.Errorf("empty client"))
+	err.AddIf(c.Limit < 1, fmt.Errorf("limit must be positive"))
+
+	return err.Err()
+}
+
+type client struct {
+	url    string
+	client *http.Client
+	limit  int
+}
+
+func New(cfg Config) domain.Client {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
 	return client{
-		Limit: limit,
+		url:    cfg.URL,
+		client: cfg.Client,
+		limit:  cfg.Limit,
 	}
 }
 
-func (s client) Fetch() (domain.FetchedGame, error) {
-	var games []game
+func (s client) Fetch(ctx context.Context) (domain.FetchedGame, error) {
+	games, err := s.get(ctx, domain.ListRequest{
+		Page:  rand.Intn(amountOfGames/s.limit) + 1,
+		Limit: s.limit,
+	})
+	if err != nil {
+		return domain.FetchedGame{}, err
+	}
+
+	if len(games) == 0 {
+		return domain.FetchedGame{}, domain.ErrNoData
+	}
+
+	return games[rand.Intn(len(games))].domain(), nil
+}
+
+func (s client) List(ctx context.Context, req domain.ListRequest) (domain.ListResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.ListResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
 
-	r, err := http.Get(s.getUrl())
+	games, err := s.get(ctx, req)
 	if err != nil {
-		return domain.FetchedGame{}, fmt.Errorf("failed to fetch games: %v", err)
+		return domain.ListResponse{}, err
 	}
-	defer r.Body.Close()
 
-	if err := json.NewDecoder(r.Body).Decode(&games); err != nil {
-		return domain.FetchedGame{}, fmt.Errorf("failed to decode games: %v", err)
+	fetched := make([]domain.FetchedGame, 0, len(games))
+	for _, g := range games {
+		fetched = append(fetched, g.domain())
 	}
 
-	if len(games) == 0 {
-		return domain.FetchedGame{}, domain.ErrNoData
+	return req.Response(fetched, amountOfGames), nil
+}
+
+func (s client) get(ctx context.Context, req domain.ListRequest) ([]game, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, s.listURL(req), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	r, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch games: %w", err)
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch games: unexpected status code %d", r.StatusCode)
 	}
 
-	randGame := games[rand.Intn(len(games))]
-	return randGame.domain(), nil
+	var games []game
+	if err := json.NewDecoder(r.Body).Decode(&games); err != nil {
+		return nil, fmt.Errorf("failed to decode games: %w", err)
+	}
 
+	return games, nil
 }
 
-func (s client) getUrl() string {
-	maxPages := amountOfGames / s.Limit
+func (s client) listURL(req domain.ListRequest) string {
+	category, mobile := "All", "all"
 
-	page := rand.Intn(maxPages + 1)
+	if req.Category != "" {
+		category = req.Category
+	}
+
+	if req.MobileOnly {
+		mobile = "1"
+	}
+
+	q := url.Values{
+		"collection": {"all"},
+		"categories": {category},
+		"tags":       {"All"},
+		"subType":    {"all"},
+		"type":       {"all"},
+		"mobile":     {mobile},
+		"rewarded":   {"all"},
+		"amount":     {strconv.Itoa(req.Limit)},
+		"page":       {strconv.Itoa(req.Page)},
+		"format":     {"json"},
+	}
 
-	return fmt.Sprintf("https://catalog.api.gamedistribution.com/api/v2.0/rss/All/?collection=all&categories=All&tags=All&subType=all&type=all&mobile=all&rewarded=all&amount=%d&page=%d&format=json", s.Limit, page)
+	return s.url + "?" + q.Encode()
 }
@@ -0,0 +1,102 @@
+package gamedistribution
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/vediagames/platform/fetcher/domain"
+)
+
+func TestClient_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       domain.ListRequest
+		wantQuery url.Values
+		wantIDs   []string
+		wantTotal int
+		wantNext  bool
+	}{
+		{
+			name: "first page",
+			req:  domain.ListRequest{Page: 1, Limit: 2},
+			wantQuery: url.Values{
+				"categories": {"All"},
+				"mobile":     {"all"},
+				"amount":     {"2"},
+				"page":       {"1"},
+			},
+			wantIDs:   []string{"2f8e4a7c9b1d4e6f8a0c2e4b6d8f0a1c", "7c1e3a5b7d9f1b3d5f7a9c1e3b5d7f9a"},
+			wantTotal: amountOfGames,
+			wantNext:  true,
+		},
+		{
+			name: "filtered last page",
+			req:  domain.ListRequest{Page: 3, Limit: 10, Category: "Puzzle", MobileOnly: true},
+			wantQuery: url.Values{
+				"categories": {"Puzzle"},
+				"mobile":     {"1"},
+				"amount":     {"10"},
+				"page":       {"3"},
+			},
+			wantIDs:   []string{"2f8e4a7c9b1d4e6f8a0c2e4b6d8f0a1c", "7c1e3a5b7d9f1b3d5f7a9c1e3b5d7f9a"},
+			wantTotal: 22,
+		},
+		{
+			name: "searched page",
+			req:  domain.ListRequest{Page: 1, Limit: 2, Query: "bubble"},
+			wantQuery: url.Values{
+				"amount": {"2"},
+				"page":   {"1"},
+			},
+			wantIDs:  []string{"2f8e4a7c9b1d4e6f8a0c2e4b6d8f0a1c"},
+			wantNext: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got url.Values
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.Query()
+				http.ServeFile(w, r, "testdata/list.json")
+			}))
+			defer srv.Close()
+
+			c := New(Config{
+				URL:    srv.URL,
+				Client: srv.Client(),
+				Limit:  10,
+			})
+
+			res, err := c.List(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+
+			for k, v := range tt.wantQuery {
+				if got.Get(k) != v[0] {
+					t.Errorf("query %s = %q, want %q", k, got.Get(k), v[0])
+				}
+			}
+
+			if len(res.Data) != len(tt.wantIDs) {
+				t.Fatalf("List() returned %d games, want %d", len(res.Data), len(tt.wantIDs))
+			}
+
+			for i, g := range res.Data {
+				if g.ProviderID != tt.wantIDs[i] || g.Provider != Provider {
+					t.Errorf("game %d = %s/%s, want %s/%s", i, g.Provider, g.ProviderID, Provider, tt.wantIDs[i])
+				}
+			}
+
+			if res.Total != tt.wantTotal || res.HasNextPage != tt.wantNext || res.PageFiltered != (tt.req.Query != "") {
+				t.Errorf("List() total = %d, next = %t, filtered = %t, want %d, %t, %t",
+					res.Total, res.HasNextPage, res.PageFiltered, tt.wantTotal, tt.wantNext, tt.req.Query != "")
+			}
+		})
+	}
+}
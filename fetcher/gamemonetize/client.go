@@ -1,16 +1,26 @@
 package gamemonetize
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
+	"github.com/gosimple/slug"
+	"github.com/vediagames/zeroerror"
+
 	"github.com/vediagames/platform/fetcher/domain"
 )
 
+const (
+	Provider   = "gamemonetize"
+	DefaultURL = "https://gamemonetize.com/feed.php"
+)
+
 const amountOfGames = 19009
 const primaryResolution = "512x384"
 
@@ -33,6 +43,7 @@ type game struct {
 	Height       string `json:"height"`
 }
 
+// domain does not flag mobile games, the feed does not tell them apart.
 func (g game) domain() domain.FetchedGame {
 	width, err := strconv.Atoi(g.Width)
 	if err != nil {
@@ -50,66 +61,168 @@ func (g game) domain() domain.FetchedGame {
 		Description: g.Description,
 		Controls:    g.Instructions,
 		Mobile:      false,
-		Height:      width,
-		Width:       height,
-		Categories:  strings.Split(g.Category, ", "),
-		Tags:        strings.Split(g.Tags, ", "),
-		Images:      getImages(g.Thumb),
+		Height:      height,
+		Width:       width,
+		Categories:  split(g.Category),
+		Tags:        split(g.Tags),
+		Images:      []string{g.Thumb},
+		Slug:        slug.Make(g.Title),
+		Provider:    Provider,
+		ProviderID:  g.ID,
 	}
 }
 
-type client struct {
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, ", ")
+}
+
+type Config struct {
+	URL    string
+	Client *http.Client
+	// Limit is the page size Fetch picks a random game from.
 	Limit int
 }
 
-func New(limit int) domain.Client {
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.URL == "", fmt.Errorf("empty URL"))
+	err.AddIf(c.Client == nil, fmt.Errorf("empty client"))
+	err.AddIf(c.Limit < 1, fmt.Errorf("limit must be positive"))
+
+	return err.Err()
+}
+
+type client struct {
+	url    string
+	client *http.Client
+	limit  int
+}
+
+func New(cfg Config) domain.Client {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
 	return client{
-		Limit: limit,
+		url:    cfg.URL,
+		client: cfg.Client,
+		limit:  cfg.Limit,
 	}
 }
 
-func (s client) Fetch() (domain.FetchedGame, error) {
-	var games []game
+func (s client) Fetch(ctx context.Context) (domain.FetchedGame, error) {
+	games, err := s.get(ctx, domain.ListRequest{
+		Page:  rand.Intn(amountOfGames/s.limit) + 1,
+		Limit: s.limit,
+	})
+	if err != nil {
+		return domain.FetchedGame{}, err
+	}
+
+	if len(games) == 0 {
+		return domain.FetchedGame{}, domain.ErrNoData
+	}
+
+	randGame := games[rand.Intn(len(games))].domain()
+	randGame.Images = s.getImages(ctx, randGame.Images[0])
+
+	return randGame, nil
+}
+
+// List does not probe for the alternative image resolutions Fetch looks up,
+// it would take a request per image and page.
+func (s client) List(ctx context.Context, req domain.ListRequest) (domain.ListResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.ListResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	if req.MobileOnly {
+		return domain.ListResponse{
+			Data: []domain.FetchedGame{},
+		}, nil
+	}
 
-	r, err := http.Get(s.getUrl())
+	games, err := s.get(ctx, req)
 	if err != nil {
-		return domain.FetchedGame{}, fmt.Errorf("failed to fetch games: %v", err)
+		return domain.ListResponse{}, err
 	}
-	defer r.Body.Close()
 
-	if err := json.NewDecoder(r.Body).Decode(&games); err != nil {
-		return domain.FetchedGame{}, fmt.Errorf("failed to decode games: %v", err)
+	fetched := make([]domain.FetchedGame, 0, len(games))
+	for _, g := range games {
+		fetched = append(fetched, g.domain())
 	}
 
-	if len(games) == 0 {
-		return domain.FetchedGame{}, domain.ErrNoData
+	return req.Response(fetched, amountOfGames), nil
+}
+
+func (s client) get(ctx context.Context, req domain.ListRequest) ([]game, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, s.listURL(req), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	r, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch games: %w", err)
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch games: unexpected status code %d", r.StatusCode)
 	}
 
-	randGame := games[rand.Intn(len(games))]
-	return randGame.domain(), nil
+	var games []game
+	if err := json.NewDecoder(r.Body).Decode(&games); err != nil {
+		return nil, fmt.Errorf("failed to decode games: %w", err)
+	}
 
+	return games, nil
 }
 
-func (s client) getUrl() string {
-	maxPages := amountOfGames / s.Limit
+func (s client) listURL(req domain.ListRequest) string {
+	q := url.Values{
+		"format": {"0"},
+		"num":    {strconv.Itoa(req.Limit)},
+		"page":   {strconv.Itoa(req.Page)},
+	}
 
-	page := rand.Intn(maxPages + 1)
+	if req.Category != "" {
+		q.Set("category", req.Category)
+	}
 
-	return fmt.Sprintf("https://gamemonetize.com/feed.php?format=0&num=%d&page=%d", s.Limit, page)
+	return s.url + "?" + q.Encode()
 }
 
-func getImages(thumb string) []string {
+func (s client) getImages(ctx context.Context, thumb string) []string {
 	var images []string
 
 	images = append(images, thumb)
 
 	for _, resolution := range imgResolutions {
-		if resolution != primaryResolution {
-			url := strings.ReplaceAll(thumb, primaryResolution, resolution)
-			r, err := http.Get(url)
-			if err == nil && r.Header.Get("content-type") == "image/jpeg" {
-				images = append(images, url)
-			}
+		if resolution == primaryResolution {
+			continue
+		}
+
+		u := strings.ReplaceAll(thumb, primaryResolution, resolution)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			continue
+		}
+
+		r, err := s.client.Do(req)
+		if err != nil {
+			continue
+		}
+		r.Body.Close()
+
+		if r.Header.Get("content-type") == "image/jpeg" {
+			images = append(images, u)
 		}
 	}
 
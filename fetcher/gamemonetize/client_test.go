@@ -0,0 +1,67 @@
+package gamemonetize
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/vediagames/platform/fetcher/domain"
+)
+
+func TestClient_List(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("category") != "Puzzle" || r.URL.Query().Get("num") != "5" {
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+			return
+		}
+
+		http.ServeFile(w, r, "testdata/list.json")
+	}))
+	defer srv.Close()
+
+	c := New(Config{
+		URL:    srv.URL,
+		Client: srv.Client(),
+		Limit:  10,
+	})
+
+	res, err := c.List(context.Background(), domain.ListRequest{
+		Page:     2,
+		Limit:    5,
+		Category: "Puzzle",
+		Query:    "candies",
+	})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := []domain.FetchedGame{
+		{
+			Name:        "Candy Match",
+			URL:         "https://html5.gamemonetize.co/f6e5d4c3b2a1/",
+			Description: "Swap candies to make rows of three.",
+			Controls:    "Drag candies with the mouse.",
+			Height:      720,
+			Width:       1280,
+			Categories:  []string{"Puzzle"},
+			Tags:        []string{"Match 3", "Candy"},
+			Images:      []string{"https://img.gamemonetize.com/f6e5d4c3b2a1/512x384.jpg"},
+			Slug:        "candy-match",
+			Provider:    Provider,
+			ProviderID:  "18346",
+		},
+	}
+
+	if !reflect.DeepEqual(res.Data, want) {
+		t.Errorf("List() data = %+v, want %+v", res.Data, want)
+	}
+
+	// Two games on a page of five means this is the last page, the number
+	// of matches is not known as the provider cannot search.
+	if res.Total != 0 || !res.PageFiltered || res.HasNextPage {
+		t.Errorf("List() total = %d, filtered = %t, next = %t, want 0, true and false",
+			res.Total, res.PageFiltered, res.HasNextPage)
+	}
+}
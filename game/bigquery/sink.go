@@ -0,0 +1,81 @@
+package bigquery
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+type sink struct {
+	client    *bigquery.Client
+	tableID   string
+	datasetID string
+}
+
+type Config struct {
+	Client    *bigquery.Client
+	TableID   string
+	DatasetID string
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Client == nil, fmt.Errorf("empty client"))
+	err.AddIf(c.TableID == "", fmt.Errorf("empty table ID"))
+	err.AddIf(c.DatasetID == "", fmt.Errorf("empty dataset ID"))
+
+	return err.Err()
+}
+
+func NewSink(cfg Config) domain.EventSink {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &sink{
+		client:    cfg.Client,
+		tableID:   cfg.TableID,
+		datasetID: cfg.DatasetID,
+	}
+}
+
+type event struct {
+	ID         int64     `bigquery:"id"`
+	GameID     int       `bigquery:"game_id"`
+	Event      string    `bigquery:"event"`
+	LoggedAt   time.Time `bigquery:"logged_at"`
+	InsertedAt time.Time `bigquery:"inserted_at"`
+}
+
+func (s sink) Send(ctx context.Context, events []domain.LoggedEvent) error {
+	insertedAt := time.Now()
+
+	rows := make([]*bigquery.StructSaver, 0, len(events))
+	for _, e := range events {
+		rows = append(rows, &bigquery.StructSaver{
+			Struct: event{
+				ID:         e.ID,
+				GameID:     e.GameID,
+				Event:      e.Event.String(),
+				LoggedAt:   e.LoggedAt,
+				InsertedAt: insertedAt,
+			},
+			// BigQuery drops rows with a recently seen insert ID, which
+			// filters most redeliveries from the outbox.
+			InsertID: strconv.FormatInt(e.ID, 10),
+		})
+	}
+
+	if err := s.client.Dataset(s.datasetID).Table(s.tableID).Inserter().Put(ctx, rows); err != nil {
+		return fmt.Errorf("failed to put: %w", err)
+	}
+
+	return nil
+}
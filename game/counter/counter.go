@@ -0,0 +1,169 @@
+package counter
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+type Config struct {
+	Repository    domain.Repository
+	Store         domain.CounterStore
+	FlushInterval time.Duration
+	// MergePending adds deltas that are not flushed yet to FindOne results.
+	MergePending bool
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Repository == nil, fmt.Errorf("empty repository"))
+	err.AddIf(c.Store == nil, fmt.Errorf("empty store"))
+	err.AddIf(c.FlushInterval <= 0, fmt.Errorf("flush interval must be positive"))
+
+	return err.Err()
+}
+
+// Repository buffers IncreaseField deltas in a CounterStore and writes them
+// to the wrapped repository in bulk from Run. All other calls go straight to
+// the wrapped repository.
+type Repository struct {
+	domain.Repository
+	store         domain.CounterStore
+	flushInterval time.Duration
+	mergePending  bool
+
+	received      atomic.Int64
+	flushed       atomic.Int64
+	flushes       atomic.Int64
+	failedFlushes atomic.Int64
+}
+
+func New(cfg Config) *Repository {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &Repository{
+		Repository:    cfg.Repository,
+		store:         cfg.Store,
+		flushInterval: cfg.FlushInterval,
+		mergePending:  cfg.MergePending,
+	}
+}
+
+// Stats are counted since the process started. Received and Flushed are sums
+// of amounts, so with a single instance Received - Flushed is what is still
+// pending. With a shared store any instance may flush the deltas of another.
+type Stats struct {
+	Received      int64 `json:"received"`
+	Flushed       int64 `json:"flushed"`
+	Flushes       int64 `json:"flushes"`
+	FailedFlushes int64 `json:"failedFlushes"`
+}
+
+func (r *Repository) Stats() Stats {
+	return Stats{
+		Received:      r.received.Load(),
+		Flushed:       r.flushed.Load(),
+		Flushes:       r.flushes.Load(),
+		FailedFlushes: r.failedFlushes.Load(),
+	}
+}
+
+func (r *Repository) IncreaseField(ctx context.Context, q domain.IncreaseFieldQuery) error {
+	if err := q.Field.Validate(); err != nil {
+		return fmt.Errorf("invalid field: %w", err)
+	}
+
+	if err := r.store.Add(ctx, q); err != nil {
+		return fmt.Errorf("failed to add: %w", err)
+	}
+
+	r.received.Add(int64(q.ByAmount))
+
+	return nil
+}
+
+func (r *Repository) FindOne(ctx context.Context, q domain.FindOneQuery) (domain.FindOneResult, error) {
+	res, err := r.Repository.FindOne(ctx, q)
+	if err != nil || !r.mergePending {
+		return res, err
+	}
+
+	pending, err := r.store.Pending(ctx, res.Data.ID)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Int("game_id", res.Data.ID).Msg("failed to get pending counters")
+		return res, nil
+	}
+
+	res.Data.Plays += pending[domain.IncreaseFieldPlays]
+	res.Data.Likes += pending[domain.IncreaseFieldLikes]
+	res.Data.Dislikes += pending[domain.IncreaseFieldDislikes]
+
+	return res, nil
+}
+
+// Flush writes all pending deltas to the wrapped repository. Deltas of a
+// failed write stay in the store and are retried on the next Flush.
+func (r *Repository) Flush(ctx context.Context) error {
+	deltas, err := r.store.Drain(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drain: %w", err)
+	}
+
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	if err := r.Repository.IncreaseFields(ctx, domain.IncreaseFieldsQuery{Deltas: deltas}); err != nil {
+		r.failedFlushes.Add(1)
+		return fmt.Errorf("failed to increase fields: %w", err)
+	}
+
+	// The deltas are written at this point, a failed ack means they get
+	// written again on the next flush.
+	if err := r.store.Ack(ctx); err != nil {
+		return fmt.Errorf("failed to ack: %w", err)
+	}
+
+	var amount int64
+	for _, d := range deltas {
+		amount += int64(d.ByAmount)
+	}
+
+	r.flushed.Add(amount)
+	r.flushes.Add(1)
+
+	return nil
+}
+
+// Run flushes every flush interval until ctx is done, then flushes once more.
+func (r *Repository) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			if err := r.Flush(zerolog.Ctx(ctx).WithContext(flushCtx)); err != nil {
+				return fmt.Errorf("failed to flush on shutdown: %w", err)
+			}
+
+			return nil
+		case <-ticker.C:
+			if err := r.Flush(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Interface("stats", r.Stats()).Msg("failed to flush counters")
+			}
+		}
+	}
+}
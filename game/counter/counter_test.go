@@ -0,0 +1,118 @@
+package counter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+type fakeRepository struct {
+	domain.Repository
+	game    domain.Game
+	err     error
+	flushed map[int]int
+}
+
+func (r *fakeRepository) FindOne(context.Context, domain.FindOneQuery) (domain.FindOneResult, error) {
+	return domain.FindOneResult{Data: r.game}, nil
+}
+
+func (r *fakeRepository) IncreaseFields(_ context.Context, q domain.IncreaseFieldsQuery) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	for _, d := range q.Deltas {
+		r.flushed[d.ID] += d.ByAmount
+	}
+
+	return nil
+}
+
+func TestRepository_Flush(t *testing.T) {
+	tests := []struct {
+		name        string
+		failFlushes int
+		wantStats   Stats
+	}{
+		{
+			name: "flushes all deltas",
+			wantStats: Stats{
+				Received: 4,
+				Flushed:  4,
+				Flushes:  1,
+			},
+		},
+		{
+			name:        "keeps deltas of failed flushes",
+			failFlushes: 2,
+			wantStats: Stats{
+				Received:      4,
+				Flushed:       4,
+				Flushes:       1,
+				FailedFlushes: 2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &fakeRepository{
+				game:    domain.Game{ID: 1, Plays: 10},
+				flushed: make(map[int]int),
+			}
+
+			r := New(Config{
+				Repository:    repo,
+				Store:         NewMemoryStore(),
+				FlushInterval: time.Second,
+				MergePending:  true,
+			})
+
+			for _, q := range []domain.IncreaseFieldQuery{
+				{ID: 1, Field: domain.IncreaseFieldPlays, ByAmount: 1},
+				{ID: 1, Field: domain.IncreaseFieldPlays, ByAmount: 1},
+				{ID: 2, Field: domain.IncreaseFieldLikes, ByAmount: 2},
+			} {
+				if err := r.IncreaseField(ctx, q); err != nil {
+					t.Fatalf("IncreaseField() error = %v", err)
+				}
+			}
+
+			res, err := r.FindOne(ctx, domain.FindOneQuery{})
+			if err != nil {
+				t.Fatalf("FindOne() error = %v", err)
+			}
+
+			if res.Data.Plays != 12 {
+				t.Errorf("FindOne() plays = %d, want 12", res.Data.Plays)
+			}
+
+			for i := 0; i < tt.failFlushes; i++ {
+				repo.err = errors.New("database is down")
+
+				if err := r.Flush(ctx); err == nil {
+					t.Fatalf("Flush() expected error")
+				}
+			}
+
+			repo.err = nil
+
+			if err := r.Flush(ctx); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+
+			if repo.flushed[1] != 2 || repo.flushed[2] != 2 {
+				t.Errorf("flushed = %v, want map[1:2 2:2]", repo.flushed)
+			}
+
+			if got := r.Stats(); got != tt.wantStats {
+				t.Errorf("Stats() = %+v, want %+v", got, tt.wantStats)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package counter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+type key struct {
+	id    int
+	field domain.IncreasableField
+}
+
+type memoryStore struct {
+	mu       sync.Mutex
+	pending  map[key]int
+	draining map[key]int
+}
+
+// NewMemoryStore keeps deltas in process memory. Deltas that were not
+// flushed are lost when the process dies.
+func NewMemoryStore() domain.CounterStore {
+	return &memoryStore{
+		pending: make(map[key]int),
+	}
+}
+
+func (s *memoryStore) Add(_ context.Context, q domain.IncreaseFieldQuery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending[key{id: q.ID, field: q.Field}] += q.ByAmount
+
+	return nil
+}
+
+func (s *memoryStore) Drain(_ context.Context) ([]domain.IncreaseFieldQuery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.draining == nil {
+		s.draining, s.pending = s.pending, make(map[key]int)
+	}
+
+	deltas := make([]domain.IncreaseFieldQuery, 0, len(s.draining))
+	for k, amount := range s.draining {
+		deltas = append(deltas, domain.IncreaseFieldQuery{
+			ID:       k.id,
+			Field:    k.field,
+			ByAmount: amount,
+		})
+	}
+
+	return deltas, nil
+}
+
+func (s *memoryStore) Ack(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.draining = nil
+
+	return nil
+}
+
+func (s *memoryStore) Pending(_ context.Context, id int) (map[domain.IncreasableField]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make(map[domain.IncreasableField]int)
+
+	for _, m := range []map[key]int{s.pending, s.draining} {
+		for k, amount := range m {
+			if k.id == id {
+				res[k.field] += amount
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/vediagames/zeroerror"
@@ -27,6 +28,12 @@ func (t Texts) Validate() error {
 	return err.Err()
 }
 
+type PlayedGame struct {
+	Game         Game
+	Plays        int
+	LastPlayedAt time.Time
+}
+
 type Games struct {
 	Data  []Game
 	Total int
@@ -173,7 +180,7 @@ func (m SortingMethod) Validate() error {
 	case SortingMethodNewest, SortingMethodOldest, SortingMethodMostPopular, SortingMethodLeastDisliked,
 		SortingMethodLeastPopular, SortingMethodLeastLiked, SortingMethodMostLiked,
 		SortingMethodMostDisliked, SortingMethodID, SortingMethodRandom, SortingMethodName,
-		SortingMethodMostRelevant:
+		SortingMethodMostRelevant, SortingMethodTrending:
 		return nil
 	}
 
@@ -197,13 +204,14 @@ const (
 	SortingMethodMostDisliked  SortingMethod = "most-disliked"
 	SortingMethodLeastDisliked SortingMethod = "least-disliked"
 	SortingMethodMostRelevant  SortingMethod = "most-relevant"
+	SortingMethodTrending      SortingMethod = "trending"
 )
 
 type Status string
 
 func (s Status) Validate() error {
 	switch s {
-	case StatusPublished, StatusInvisible, StatusDeleted:
+	case StatusPublished, StatusInvisible, StatusDeleted, StatusScheduled:
 		return nil
 	}
 
@@ -218,6 +226,9 @@ const (
 	StatusDeleted   Status = "deleted"
 	StatusPublished Status = "published"
 	StatusInvisible Status = "invisible"
+	// StatusScheduled games wait for the publish time of their Schedule.
+	// They are never listed, whatever the request allows.
+	StatusScheduled Status = "scheduled"
 )
 
 type GetByField string
@@ -287,3 +298,55 @@ func (e Event) Validate() error {
 
 	return fmt.Errorf("%w: %q", ErrInvalidValue, e)
 }
+
+func (e Event) String() string {
+	return string(e)
+}
+
+// Reaction is what a visitor thinks of a game. A visitor has at most one
+// reaction per game, ReactionNone removes it.
+type Reaction string
+
+func (r Reaction) Validate() error {
+	switch r {
+	case ReactionNone, ReactionLike, ReactionDislike:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrInvalidValue, r)
+}
+
+func (r Reaction) String() string {
+	return string(r)
+}
+
+const (
+	ReactionNone    Reaction = "none"
+	ReactionLike    Reaction = "like"
+	ReactionDislike Reaction = "dislike"
+)
+
+// Visitor identifies who reacts to a game, either a signed in user or an
+// anonymous session created through /session/create.
+type Visitor string
+
+func VisitorFromUser(id string) Visitor {
+	return Visitor("user:" + id)
+}
+
+func VisitorFromSession(id string) Visitor {
+	return Visitor("session:" + id)
+}
+
+func (v Visitor) Validate() error {
+	kind, id, ok := strings.Cut(string(v), ":")
+	if !ok || id == "" || len(v) > 128 || (kind != "user" && kind != "session") {
+		return fmt.Errorf("%w: %q", ErrInvalidValue, v)
+	}
+
+	return nil
+}
+
+func (v Visitor) String() string {
+	return string(v)
+}
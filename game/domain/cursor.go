@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Cursor points at a game of a listing, the page after it starts with the
+// game that follows in the sort order. Clients get it as an opaque string.
+type Cursor struct {
+	Sort SortingMethod `json:"s"`
+	// Keys are the values the game is sorted by in their text form, the ID
+	// breaks ties.
+	Keys []string `json:"k,omitempty"`
+	ID   int      `json:"i"`
+	// Seed keeps a random order the same across pages.
+	Seed string `json:"r,omitempty"`
+	// Snapshot is the trending snapshot a trending order was read from, the
+	// cursor is invalid once it is replaced.
+	Snapshot string `json:"t,omitempty"`
+}
+
+func (c Cursor) IsZero() bool {
+	return c.ID == 0
+}
+
+func (c Cursor) Validate() error {
+	if c.ID < 1 {
+		return ErrInvalidID
+	}
+
+	if ve := c.Sort.Validate(); ve != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSortingMethod, ve)
+	}
+
+	return nil
+}
+
+func (c Cursor) String() string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal cursor: %w", err))
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func ParseCursor(s string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: failed to decode: %w", ErrInvalidCursor, err)
+	}
+
+	var c Cursor
+
+	if err := json.Unmarshal(b, &c); err != nil {
+		return Cursor{}, fmt.Errorf("%w: failed to unmarshal: %w", ErrInvalidCursor, err)
+	}
+
+	if ve := c.Validate(); ve != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", ErrInvalidCursor, ve)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseCursor(t *testing.T) {
+	c := Cursor{
+		Sort:     SortingMethodTrending,
+		Keys:     []string{"12.5", "300"},
+		ID:       42,
+		Snapshot: "2023-10-26 09:00:00+00",
+	}
+
+	got, err := ParseCursor(c.String())
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, c) {
+		t.Errorf("got %+v, want %+v", got, c)
+	}
+
+	for _, s := range []string{"", "not a cursor", Cursor{Sort: SortingMethodID}.String()} {
+		if _, err := ParseCursor(s); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("ParseCursor(%q) error = %v, want %s", s, err, ErrInvalidCursor)
+		}
+	}
+}
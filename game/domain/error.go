@@ -40,4 +40,13 @@ const (
 	ErrInvalidValue                 = Error("invalid value")
 	ErrInvalidData                  = Error("invalid data")
 	ErrEmptyShortDescription        = Error("empty short description")
+	ErrInvalidReaction              = Error("invalid reaction")
+	ErrInvalidVisitor               = Error("invalid visitor")
+	ErrInvalidRevision              = Error("invalid revision")
+	ErrInvalidRevisionAction        = Error("invalid revision action")
+	ErrInvalidSchedule              = Error("invalid schedule")
+	ErrInvalidPurge                 = Error("invalid purge")
+	ErrInvalidCursor                = Error("invalid cursor")
+	ErrInvalidFirst                 = Error("invalid first")
+	ErrRateLimited                  = Error("rate limited")
 )
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// PurgedGame reports what was removed with a game. Rows counts the deleted
+// rows by table, Assets the deleted bucket objects.
+type PurgedGame struct {
+	ID        int
+	Slug      string
+	DeletedAt time.Time
+	Rows      map[string]int
+	Assets    int
+	// Error is set when the game was only partly purged, e.g. its rows are
+	// gone but its assets could not be deleted.
+	Error string
+}
+
+// AssetPrefix is where the bucket objects of a game live, see the importer
+// and image services.
+func AssetPrefix(slug string) string {
+	return "games/" + slug + "/"
+}
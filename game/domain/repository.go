@@ -9,18 +9,49 @@ type Repository interface {
 	Find(context.Context, FindQuery) (FindResult, error)
 	FindOne(context.Context, FindOneQuery) (FindOneResult, error)
 	IncreaseField(context.Context, IncreaseFieldQuery) error
+	IncreaseFields(context.Context, IncreaseFieldsQuery) error
 	Search(context.Context, SearchQuery) (SearchResult, error)
 	FullSearch(context.Context, FullSearchQuery) (FullSearchResult, error)
 	FindMostPlayedIDsByDate(context.Context, FindMostPlayedIDsByDateQuery) (FindMostPlayedIDsByDateResult, error)
 	Insert(context.Context, InsertQuery) (InsertResult, error)
 	Update(context.Context, UpdateQuery) (UpdateResult, error)
 	Delete(context.Context, DeleteQuery) (DeleteResult, error)
+	React(context.Context, ReactQuery) (ReactResult, error)
+	FindReactions(context.Context, FindReactionsQuery) (FindReactionsResult, error)
+	RecordPlay(context.Context, RecordPlayQuery) error
+	FindPlayHistory(context.Context, FindPlayHistoryQuery) (FindPlayHistoryResult, error)
+	FindRevisions(context.Context, FindRevisionsQuery) (FindRevisionsResult, error)
+	FindRevision(context.Context, FindRevisionQuery) (FindRevisionResult, error)
+	Rollback(context.Context, RollbackQuery) (RollbackResult, error)
+	SaveSchedule(context.Context, SaveScheduleQuery) (SaveScheduleResult, error)
+	DeleteSchedule(context.Context, DeleteScheduleQuery) (DeleteScheduleResult, error)
+	FindSchedules(context.Context, FindSchedulesQuery) (FindSchedulesResult, error)
+	ApplySchedules(context.Context, ApplySchedulesQuery) (ApplySchedulesResult, error)
+	Restore(context.Context, RestoreQuery) (RestoreResult, error)
+	FindDeleted(context.Context, FindDeletedQuery) (FindDeletedResult, error)
+	Purge(context.Context, PurgeQuery) (PurgeResult, error)
+	FindSlugRedirect(context.Context, FindSlugRedirectQuery) (FindSlugRedirectResult, error)
 }
 
 type EventRepository interface {
 	Log(context.Context, LogQuery) error
 }
 
+// EventSink receives logged events relayed from the event outbox. Delivery is
+// at-least-once, a sink may receive the same event again after a failed batch.
+type EventSink interface {
+	Send(context.Context, []LoggedEvent) error
+}
+
+type LoggedEvent struct {
+	ID       int64
+	GameID   int
+	Event    Event
+	LoggedAt time.Time
+}
+
+// UpdateQuery leaves plays, likes and dislikes out, only IncreaseField and
+// the counter flush change them.
 type UpdateQuery struct {
 	ID             int
 	Slug           string
@@ -31,11 +62,9 @@ type UpdateQuery struct {
 	URL            string
 	Width          int
 	Height         int
-	Likes          int
-	Dislikes       int
-	Plays          int
 	Weight         int
 	Texts          map[Language]Texts
+	Author         string
 }
 
 type UpdateResult struct {
@@ -43,8 +72,9 @@ type UpdateResult struct {
 }
 
 type DeleteQuery struct {
-	ID   int
-	Slug string
+	ID     int
+	Slug   string
+	Author string
 }
 
 type DeleteResult struct {
@@ -61,6 +91,7 @@ type InsertQuery struct {
 	Height         int
 	Weight         int
 	Texts          map[Language]Texts
+	Author         string
 }
 
 type InsertResult struct {
@@ -104,6 +135,20 @@ type IncreaseFieldQuery struct {
 	ByAmount int
 }
 
+type IncreaseFieldsQuery struct {
+	Deltas []IncreaseFieldQuery
+}
+
+// CounterStore holds counter deltas that are not yet written to the
+// repository. Drained deltas stay reserved until they are acknowledged, so a
+// failed flush picks them up again on the next Drain.
+type CounterStore interface {
+	Add(context.Context, IncreaseFieldQuery) error
+	Drain(context.Context) ([]IncreaseFieldQuery, error)
+	Ack(context.Context) error
+	Pending(ctx context.Context, id int) (map[IncreasableField]int, error)
+}
+
 type FindOneQuery struct {
 	Field    GetByField
 	Value    interface{}
@@ -128,10 +173,15 @@ type FindQuery struct {
 	ExcludedIDRefs  []int
 	MobileOnly      bool
 	Slugs           []string
+	// First is set instead of Page and Limit to page by cursor.
+	First int
+	After Cursor
 }
 
 type FindResult struct {
-	Data Games
+	Data        Games
+	Cursors     []Cursor
+	HasNextPage bool
 }
 
 type FindMostPlayedIDsByDateQuery struct {
@@ -145,3 +195,169 @@ type FindMostPlayedIDsByDateQuery struct {
 type FindMostPlayedIDsByDateResult struct {
 	Data []int
 }
+
+// ReactQuery stores the reaction of a visitor and moves the likes and
+// dislikes counters of the game along with it.
+type ReactQuery struct {
+	ID       int
+	Visitor  Visitor
+	Reaction Reaction
+}
+
+type ReactResult struct {
+	// Previous is the reaction the visitor had before.
+	Previous Reaction
+	Likes    int
+	Dislikes int
+}
+
+type FindReactionsQuery struct {
+	IDs     []int
+	Visitor Visitor
+}
+
+type FindReactionsResult struct {
+	Data map[int]Reaction
+}
+
+type RecordPlayQuery struct {
+	ID      int
+	Visitor Visitor
+}
+
+type FindPlayHistoryQuery struct {
+	Visitor Visitor
+	Page    int
+	Limit   int
+}
+
+// FindPlayHistoryResult holds the history of the visitor, the last played
+// first.
+type FindPlayHistoryResult struct {
+	Data  []Play
+	Total int
+}
+
+type Play struct {
+	ID           int
+	Plays        int
+	LastPlayedAt time.Time
+}
+
+type FindRevisionsQuery struct {
+	ID    int
+	Page  int
+	Limit int
+}
+
+type FindRevisionsResult struct {
+	Data  []Revision
+	Total int
+}
+
+type FindRevisionQuery struct {
+	ID     int
+	Number int
+}
+
+type FindRevisionResult struct {
+	Data Revision
+}
+
+// RollbackQuery writes the snapshot of the revision back and records a new
+// revision, all in one transaction.
+type RollbackQuery struct {
+	ID       int
+	Revision int
+	Author   string
+}
+
+type RollbackResult struct {
+	Data Game
+}
+
+type SaveScheduleQuery struct {
+	Schedule Schedule
+	Author   string
+}
+
+type SaveScheduleResult struct {
+	Data Game
+}
+
+type DeleteScheduleQuery struct {
+	ID     int
+	Author string
+}
+
+type DeleteScheduleResult struct {
+	Data Game
+}
+
+type FindSchedulesQuery struct {
+	Page  int
+	Limit int
+}
+
+type FindSchedulesResult struct {
+	Data  []Schedule
+	Total int
+}
+
+// ApplySchedulesQuery publishes the scheduled games whose publish time has
+// come and unpublishes the games whose unpublish time has come.
+type ApplySchedulesQuery struct {
+	Author string
+}
+
+type ApplySchedulesResult struct {
+	Published   []int
+	Unpublished []int
+}
+
+type RestoreQuery struct {
+	ID     int
+	Slug   string
+	Author string
+}
+
+type RestoreResult struct {
+	Data Game
+}
+
+// FindDeletedQuery finds deleted games by ID, or all deleted before
+// DeletedBefore.
+type FindDeletedQuery struct {
+	IDs           []int
+	DeletedBefore time.Time
+}
+
+type FindDeletedResult struct {
+	Data []DeletedGame
+}
+
+type DeletedGame struct {
+	ID        int
+	Slug      string
+	DeletedAt time.Time
+}
+
+// PurgeQuery removes a deleted game and every row referring to it, in one
+// transaction. A dry run rolls the transaction back.
+type PurgeQuery struct {
+	ID     int
+	DryRun bool
+}
+
+type PurgeResult struct {
+	Rows map[string]int
+}
+
+// FindSlugRedirectQuery finds the game that had Slug before it was renamed.
+type FindSlugRedirectQuery struct {
+	Slug string
+}
+
+type FindSlugRedirectResult struct {
+	ID int
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuthorSystem is recorded for changes whose request has no author.
+const AuthorSystem = "system"
+
+type RevisionAction string
+
+func (a RevisionAction) Validate() error {
+	switch a {
+	case RevisionActionCreate, RevisionActionEdit, RevisionActionRemove, RevisionActionRollback,
+		RevisionActionRestore:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrInvalidValue, a)
+}
+
+func (a RevisionAction) String() string {
+	return string(a)
+}
+
+const (
+	RevisionActionCreate   RevisionAction = "create"
+	RevisionActionEdit     RevisionAction = "edit"
+	RevisionActionRemove   RevisionAction = "remove"
+	RevisionActionRollback RevisionAction = "rollback"
+	RevisionActionRestore  RevisionAction = "restore"
+)
+
+// Snapshot is the editable state of a game in every language. Counters are
+// left out, they are not edited and are never rolled back.
+type Snapshot struct {
+	Slug           string
+	Mobile         bool
+	TagIDRefs      IDs
+	CategoryIDRefs IDs
+	Status         Status
+	URL            string
+	Width          int
+	Height         int
+	Weight         int
+	Texts          map[Language]Texts
+}
+
+// Revision is the state of a game after a change. Numbers start at 1 for
+// every game.
+type Revision struct {
+	GameID        int
+	Number        int
+	Action        RevisionAction
+	Author        string
+	CreatedAt     time.Time
+	ChangedFields []string
+	Snapshot      Snapshot
+}
+
+func (r Revision) Validate() error {
+	if r.GameID < 1 || r.Number < 1 {
+		return fmt.Errorf("%w: game %d revision %d", ErrInvalidID, r.GameID, r.Number)
+	}
+
+	if err := r.Action.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRevisionAction, err)
+	}
+
+	return nil
+}
+
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// Diff lists the fields that differ from s to other. Texts are compared per
+// language, as "texts.<language>.<field>". Tags and categories are compared
+// as sets.
+func (s Snapshot) Diff(other Snapshot) []FieldChange {
+	var changes []FieldChange
+
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+
+	add("slug", s.Slug, other.Slug)
+	add("mobile", strconv.FormatBool(s.Mobile), strconv.FormatBool(other.Mobile))
+	add("tags", idSet(s.TagIDRefs), idSet(other.TagIDRefs))
+	add("categories", idSet(s.CategoryIDRefs), idSet(other.CategoryIDRefs))
+	add("status", s.Status.String(), other.Status.String())
+	add("url", s.URL, other.URL)
+	add("width", strconv.Itoa(s.Width), strconv.Itoa(other.Width))
+	add("height", strconv.Itoa(s.Height), strconv.Itoa(other.Height))
+	add("weight", strconv.Itoa(s.Weight), strconv.Itoa(other.Weight))
+
+	languages := make(map[Language]struct{}, len(s.Texts)+len(other.Texts))
+	for l := range s.Texts {
+		languages[l] = struct{}{}
+	}
+	for l := range other.Texts {
+		languages[l] = struct{}{}
+	}
+
+	sorted := make([]Language, 0, len(languages))
+	for l := range languages {
+		sorted = append(sorted, l)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	for _, l := range sorted {
+		from, to := s.Texts[l], other.Texts[l]
+		prefix := "texts." + l.String() + "."
+
+		add(prefix+"name", from.Name, to.Name)
+		add(prefix+"shortDescription", from.ShortDescription, to.ShortDescription)
+		add(prefix+"description", from.Description, to.Description)
+		add(prefix+"content", from.Content, to.Content)
+		add(prefix+"player1Controls", from.Player1Controls, to.Player1Controls)
+		add(prefix+"player2Controls", from.Player2Controls, to.Player2Controls)
+	}
+
+	return changes
+}
+
+func idSet(ids IDs) string {
+	sorted := append([]int(nil), ids...)
+	sort.Ints(sorted)
+
+	parts := make([]string, 0, len(sorted))
+	for i, id := range sorted {
+		if i > 0 && id == sorted[i-1] {
+			continue
+		}
+
+		parts = append(parts, strconv.Itoa(id))
+	}
+
+	return strings.Join(parts, ",")
+}
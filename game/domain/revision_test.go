@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSnapshot_Diff(t *testing.T) {
+	from := Snapshot{
+		Slug:           "snake",
+		TagIDRefs:      IDs{3, 1},
+		CategoryIDRefs: IDs{2},
+		Status:         StatusPublished,
+		Texts: map[Language]Texts{
+			LanguageEnglish: {Name: "Snake"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		to   Snapshot
+		want []FieldChange
+	}{
+		{
+			name: "same",
+			to:   from,
+		},
+		{
+			name: "tags are a set",
+			to: Snapshot{
+				Slug:           "snake",
+				TagIDRefs:      IDs{1, 3, 3},
+				CategoryIDRefs: IDs{2},
+				Status:         StatusPublished,
+				Texts:          from.Texts,
+			},
+		},
+		{
+			name: "changed fields and new language",
+			to: Snapshot{
+				Slug:           "snake-2",
+				TagIDRefs:      IDs{1},
+				CategoryIDRefs: IDs{2},
+				Status:         StatusDeleted,
+				Texts: map[Language]Texts{
+					LanguageEnglish: {Name: "Snake"},
+					LanguageEspanol: {Name: "Serpiente"},
+				},
+			},
+			want: []FieldChange{
+				{Field: "slug", From: "snake", To: "snake-2"},
+				{Field: "tags", From: "1,3", To: "1"},
+				{Field: "status", From: "published", To: "deleted"},
+				{Field: "texts.es.name", From: "", To: "Serpiente"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := from.Diff(tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
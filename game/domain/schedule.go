@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// AuthorScheduler is recorded on the revisions of games published or
+// unpublished by their schedule.
+const AuthorScheduler = "scheduler"
+
+// Schedule is when a game goes live and, optionally, when it is taken down
+// again. Zero times are not set.
+type Schedule struct {
+	GameID      int
+	PublishAt   time.Time
+	UnpublishAt time.Time
+}
+
+func (s Schedule) Validate() error {
+	if s.GameID < 1 {
+		return ErrInvalidID
+	}
+
+	if s.PublishAt.IsZero() && s.UnpublishAt.IsZero() {
+		return fmt.Errorf("%w: no publish or unpublish time", ErrInvalidSchedule)
+	}
+
+	if !s.PublishAt.IsZero() && !s.UnpublishAt.IsZero() && !s.UnpublishAt.After(s.PublishAt) {
+		return fmt.Errorf("%w: unpublish time is not after publish time", ErrInvalidSchedule)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_Validate(t *testing.T) {
+	now := time.Date(2023, 10, 28, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		wantErr  bool
+	}{
+		{
+			name:     "publish only",
+			schedule: Schedule{GameID: 1, PublishAt: now},
+		},
+		{
+			name:     "unpublish only",
+			schedule: Schedule{GameID: 1, UnpublishAt: now},
+		},
+		{
+			name:     "window",
+			schedule: Schedule{GameID: 1, PublishAt: now, UnpublishAt: now.Add(48 * time.Hour)},
+		},
+		{
+			name:     "no times",
+			schedule: Schedule{GameID: 1},
+			wantErr:  true,
+		},
+		{
+			name:     "unpublish before publish",
+			schedule: Schedule{GameID: 1, PublishAt: now, UnpublishAt: now},
+			wantErr:  true,
+		},
+		{
+			name:     "no game",
+			schedule: Schedule{PublishAt: now},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.schedule.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
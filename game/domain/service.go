@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/vediagames/zeroerror"
 )
@@ -15,8 +16,20 @@ type Service interface {
 	Create(context.Context, CreateRequest) (CreateResponse, error)
 	Edit(context.Context, EditRequest) (EditResponse, error)
 	Remove(context.Context, RemoveRequest) (RemoveResponse, error)
+	Restore(context.Context, RestoreRequest) (RestoreResponse, error)
+	Purge(context.Context, PurgeRequest) (PurgeResponse, error)
+	ListRevisions(context.Context, ListRevisionsRequest) (ListRevisionsResponse, error)
+	DiffRevisions(context.Context, DiffRevisionsRequest) (DiffRevisionsResponse, error)
+	Rollback(context.Context, RollbackRequest) (RollbackResponse, error)
+	Schedule(context.Context, ScheduleRequest) (ScheduleResponse, error)
+	Unschedule(context.Context, UnscheduleRequest) (UnscheduleResponse, error)
+	ListSchedules(context.Context, ListSchedulesRequest) (ListSchedulesResponse, error)
+	ApplySchedules(context.Context) (ApplySchedulesResponse, error)
 
 	LogEvent(context.Context, LogEventRequest) error
+	GetRecentlyPlayed(context.Context, GetRecentlyPlayedRequest) (GetRecentlyPlayedResponse, error)
+	React(context.Context, ReactRequest) (ReactResponse, error)
+	GetReactions(context.Context, GetReactionsRequest) (GetReactionsResponse, error)
 
 	Search(context.Context, SearchRequest) (SearchResponse, error)
 	FullSearch(context.Context, FullSearchRequest) (FullSearchResponse, error)
@@ -32,11 +45,9 @@ type EditRequest struct {
 	URL            string
 	Width          int
 	Height         int
-	Likes          int
-	Dislikes       int
-	Plays          int
 	Weight         int
 	Texts          map[Language]Texts
+	Author         string
 }
 
 func (r EditRequest) Validate() error {
@@ -61,6 +72,10 @@ func (r EditRequest) Validate() error {
 		err.Add(fmt.Errorf("%w: %w", ErrInvalidStatus, ve))
 	}
 
+	// Only Schedule makes a game scheduled, together with the schedule that
+	// publishes it.
+	err.AddIf(r.Status == StatusScheduled, fmt.Errorf("%w: %q is set by scheduling", ErrInvalidStatus, r.Status))
+
 	for l, t := range r.Texts {
 		if ve := l.Validate(); ve != nil {
 			err.Add(fmt.Errorf("%w: %w", ErrInvalidLanguage, ve))
@@ -89,8 +104,9 @@ func (r EditResponse) Validate() error {
 }
 
 type RemoveRequest struct {
-	ID   int
-	Slug string
+	ID     int
+	Slug   string
+	Author string
 }
 
 func (r RemoveRequest) Validate() error {
@@ -123,6 +139,7 @@ type CreateRequest struct {
 	Height         int
 	Weight         int
 	Texts          map[Language]Texts
+	Author         string
 }
 
 func (r CreateRequest) Validate() error {
@@ -146,6 +163,10 @@ func (r CreateRequest) Validate() error {
 		err.Add(fmt.Errorf("%w: %w", ErrInvalidStatus, ve))
 	}
 
+	// Only Schedule makes a game scheduled, together with the schedule that
+	// publishes it.
+	err.AddIf(r.Status == StatusScheduled, fmt.Errorf("%w: %q is set by scheduling", ErrInvalidStatus, r.Status))
+
 	for l, t := range r.Texts {
 		if ve := l.Validate(); ve != nil {
 			err.Add(fmt.Errorf("%w: %w", ErrInvalidLanguage, ve))
@@ -311,8 +332,11 @@ func (r GetRequest) Validate() error {
 	return err.Err()
 }
 
+// GetResponse has RedirectTo set to the current slug when the game was
+// requested by a slug it had before.
 type GetResponse struct {
-	Data Game
+	Data       Game
+	RedirectTo string
 }
 
 func (r GetResponse) Validate() error {
@@ -409,17 +433,35 @@ type ListRequest struct {
 	Slugs          []string
 	MobileOnly     bool
 	Query          string
+	// First pages by cursor instead of Page and Limit, it lists the first
+	// games after the After cursor or from the start when After is zero.
+	First int
+	After Cursor
 }
 
 func (r ListRequest) Validate() error {
 	var err zeroerror.Error
 
-	if r.Page < 1 {
-		err.Add(ErrInvalidPage)
-	}
+	if r.First == 0 && r.After.IsZero() {
+		if r.Page < 1 {
+			err.Add(ErrInvalidPage)
+		}
 
-	if r.Limit < 1 {
-		err.Add(ErrInvalidLimit)
+		if r.Limit < 1 {
+			err.Add(ErrInvalidLimit)
+		}
+	} else {
+		if r.First < 1 {
+			err.Add(ErrInvalidFirst)
+		}
+
+		if r.Query != "" {
+			err.Add(fmt.Errorf("%w: cursor is not supported with a query", ErrInvalidFirst))
+		}
+
+		if !r.After.IsZero() && r.After.Sort != r.Sort {
+			err.Add(fmt.Errorf("%w: sorted by %q instead of %q", ErrInvalidCursor, r.After.Sort, r.Sort))
+		}
 	}
 
 	if ve := r.Language.Validate(); ve != nil {
@@ -455,8 +497,12 @@ func (r ListRequest) Validate() error {
 	return err.Err()
 }
 
+// ListResponse has a cursor for every game in Data when the request paged
+// by cursor, Data.Total is not counted then.
 type ListResponse struct {
-	Data Games
+	Data        Games
+	Cursors     []Cursor
+	HasNextPage bool
 }
 
 func (r ListResponse) Validate() error {
@@ -472,6 +518,11 @@ func (r ListResponse) Validate() error {
 type LogEventRequest struct {
 	ID    int
 	Event Event
+	// Visitor is who the event is logged for, plays are added to their play
+	// history.
+	Visitor Visitor
+	// IP is optional, events are throttled per IP when it is set.
+	IP string
 }
 
 func (r LogEventRequest) Validate() error {
@@ -485,5 +536,396 @@ func (r LogEventRequest) Validate() error {
 		err.Add(fmt.Errorf("%w: %w", ErrInvalidEvent, ve))
 	}
 
+	if ve := r.Visitor.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidVisitor, ve))
+	}
+
 	return err.Err()
 }
+
+type GetRecentlyPlayedRequest struct {
+	Visitor  Visitor
+	Language Language
+	Page     int
+	Limit    int
+}
+
+func (r GetRecentlyPlayedRequest) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Visitor.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidVisitor, ve))
+	}
+
+	if ve := r.Language.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidLanguage, ve))
+	}
+
+	err.AddIf(r.Page < 1, ErrInvalidPage)
+	err.AddIf(r.Limit < 1, ErrInvalidLimit)
+
+	return err.Err()
+}
+
+// GetRecentlyPlayedResponse holds the played games, the last played first.
+type GetRecentlyPlayedResponse struct {
+	Data  []PlayedGame
+	Total int
+}
+
+func (r GetRecentlyPlayedResponse) Validate() error {
+	var err zeroerror.Error
+
+	for _, played := range r.Data {
+		if ve := played.Game.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w: %w", ErrInvalidGame, ve))
+		}
+
+		err.AddIf(played.LastPlayedAt.IsZero(), fmt.Errorf("%w: empty last played at", ErrInvalidData))
+	}
+
+	err.AddIf(r.Total < 0, ErrInvalidTotal)
+
+	return err.Err()
+}
+
+type ReactRequest struct {
+	ID       int
+	Visitor  Visitor
+	Reaction Reaction
+}
+
+func (r ReactRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID < 1, ErrInvalidID)
+
+	if ve := r.Visitor.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidVisitor, ve))
+	}
+
+	if ve := r.Reaction.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidReaction, ve))
+	}
+
+	return err.Err()
+}
+
+// ReactResponse holds the counters of the game as they were when the
+// reaction was stored.
+type ReactResponse struct {
+	Reaction Reaction
+	Likes    int
+	Dislikes int
+}
+
+func (r ReactResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Reaction.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidReaction, ve))
+	}
+
+	err.AddIf(r.Likes < 0 || r.Dislikes < 0, fmt.Errorf("%w: negative counter", ErrInvalidData))
+
+	return err.Err()
+}
+
+type GetReactionsRequest struct {
+	IDs     IDs
+	Visitor Visitor
+}
+
+func (r GetReactionsRequest) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.IDs.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidIDRefs, ve))
+	}
+
+	if ve := r.Visitor.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidVisitor, ve))
+	}
+
+	return err.Err()
+}
+
+// GetReactionsResponse maps game IDs to the reaction of the visitor. Games
+// without a reaction are left out.
+type GetReactionsResponse struct {
+	Data map[int]Reaction
+}
+
+func (r GetReactionsResponse) Validate() error {
+	var err zeroerror.Error
+
+	for id, reaction := range r.Data {
+		if ve := reaction.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at game %d: %w", ErrInvalidReaction, id, ve))
+		}
+	}
+
+	return err.Err()
+}
+
+type ListRevisionsRequest struct {
+	ID    int
+	Page  int
+	Limit int
+}
+
+func (r ListRevisionsRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID < 1, ErrInvalidID)
+	err.AddIf(r.Page < 1, ErrInvalidPage)
+	err.AddIf(r.Limit < 1 || r.Limit > 100, ErrInvalidLimit)
+
+	return err.Err()
+}
+
+// ListRevisionsResponse holds the revisions, the latest first.
+type ListRevisionsResponse struct {
+	Data  []Revision
+	Total int
+}
+
+func (r ListRevisionsResponse) Validate() error {
+	var err zeroerror.Error
+
+	for i, revision := range r.Data {
+		if ve := revision.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at index %d: %w", ErrInvalidRevision, i, ve))
+		}
+	}
+
+	err.AddIf(r.Total < 0, ErrInvalidTotal)
+
+	return err.Err()
+}
+
+type DiffRevisionsRequest struct {
+	ID   int
+	From int
+	To   int
+}
+
+func (r DiffRevisionsRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID < 1, ErrInvalidID)
+	err.AddIf(r.From < 1 || r.To < 1, ErrInvalidRevision)
+
+	return err.Err()
+}
+
+type DiffRevisionsResponse struct {
+	Data []FieldChange
+}
+
+func (r DiffRevisionsResponse) Validate() error {
+	var err zeroerror.Error
+
+	for i, change := range r.Data {
+		err.AddIf(change.Field == "", fmt.Errorf("%w: empty field at index %d", ErrInvalidData, i))
+	}
+
+	return err.Err()
+}
+
+// RollbackRequest restores the game to the state of the revision. The
+// rollback is recorded as a new revision.
+type RollbackRequest struct {
+	ID       int
+	Revision int
+	Author   string
+}
+
+func (r RollbackRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID < 1, ErrInvalidID)
+	err.AddIf(r.Revision < 1, ErrInvalidRevision)
+
+	return err.Err()
+}
+
+type RollbackResponse struct {
+	Data Game
+}
+
+func (r RollbackResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+// ScheduleRequest sets when a game goes live and when it is taken down. A
+// publish time makes the game scheduled until then. A game that is already
+// published stays live and only gets the unpublish time.
+type ScheduleRequest struct {
+	ID          int
+	PublishAt   time.Time
+	UnpublishAt time.Time
+	Author      string
+}
+
+func (r ScheduleRequest) Validate() error {
+	return Schedule{
+		GameID:      r.ID,
+		PublishAt:   r.PublishAt,
+		UnpublishAt: r.UnpublishAt,
+	}.Validate()
+}
+
+type ScheduleResponse struct {
+	Data     Game
+	Schedule Schedule
+}
+
+func (r ScheduleResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	if ve := r.Schedule.Validate(); ve != nil {
+		err.Add(ve)
+	}
+
+	return err.Err()
+}
+
+// UnscheduleRequest drops the schedule of a game. A game still waiting to go
+// live becomes invisible.
+type UnscheduleRequest struct {
+	ID     int
+	Author string
+}
+
+func (r UnscheduleRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID < 1, ErrInvalidID)
+
+	return err.Err()
+}
+
+type UnscheduleResponse struct {
+	Data Game
+}
+
+func (r UnscheduleResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+type ListSchedulesRequest struct {
+	Page  int
+	Limit int
+}
+
+func (r ListSchedulesRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Page < 1, ErrInvalidPage)
+	err.AddIf(r.Limit < 1 || r.Limit > 100, ErrInvalidLimit)
+
+	return err.Err()
+}
+
+// ListSchedulesResponse holds the schedules, the next to be applied first.
+type ListSchedulesResponse struct {
+	Data  []Schedule
+	Total int
+}
+
+func (r ListSchedulesResponse) Validate() error {
+	var err zeroerror.Error
+
+	for i, schedule := range r.Data {
+		if ve := schedule.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at index %d: %w", ErrInvalidSchedule, i, ve))
+		}
+	}
+
+	err.AddIf(r.Total < 0, ErrInvalidTotal)
+
+	return err.Err()
+}
+
+// ApplySchedulesResponse holds the IDs of the games whose status was changed.
+type ApplySchedulesResponse struct {
+	Published   []int
+	Unpublished []int
+}
+
+// RestoreRequest undeletes a game by ID or slug. The game comes back
+// invisible, it has to be published again.
+type RestoreRequest struct {
+	ID     int
+	Slug   string
+	Author string
+}
+
+func (r RestoreRequest) Validate() error {
+	var err zeroerror.Error
+
+	if r.ID <= 0 && r.Slug == "" {
+		err.Add(fmt.Errorf("id and slug are both empty"))
+	}
+
+	return err.Err()
+}
+
+type RestoreResponse struct {
+	Data Game
+}
+
+func (r RestoreResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+// PurgeRequest selects deleted games to remove for good, either by ID or by
+// having been deleted before DeletedBefore. Games that are not deleted are
+// never purged. A dry run reports what would be removed.
+type PurgeRequest struct {
+	IDs           IDs
+	DeletedBefore time.Time
+	DryRun        bool
+}
+
+func (r PurgeRequest) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.IDs.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidID, ve))
+	}
+
+	err.AddIf(len(r.IDs) == 0 && r.DeletedBefore.IsZero(),
+		fmt.Errorf("%w: no IDs or deleted before time", ErrInvalidPurge))
+	err.AddIf(len(r.IDs) > 0 && !r.DeletedBefore.IsZero(),
+		fmt.Errorf("%w: both IDs and deleted before time", ErrInvalidPurge))
+
+	return err.Err()
+}
+
+type PurgeResponse struct {
+	Data []PurgedGame
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEditRequest_Validate_Status(t *testing.T) {
+	tests := []struct {
+		status  Status
+		wantErr bool
+	}{
+		{status: StatusPublished},
+		{status: StatusInvisible},
+		{status: StatusScheduled, wantErr: true},
+		{status: "unknown", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			edit := EditRequest{
+				ID:     1,
+				Slug:   "slug",
+				Status: tt.status,
+				URL:    "https://example.com",
+				Width:  800,
+				Height: 600,
+			}
+
+			create := CreateRequest{
+				Slug:   edit.Slug,
+				Status: edit.Status,
+				URL:    edit.URL,
+				Width:  edit.Width,
+				Height: edit.Height,
+			}
+
+			for name, err := range map[string]error{
+				"EditRequest":   edit.Validate(),
+				"CreateRequest": create.Validate(),
+			} {
+				if (err != nil) != tt.wantErr {
+					t.Errorf("%s.Validate() error = %v, wantErr %v", name, err, tt.wantErr)
+				}
+
+				if tt.wantErr && !errors.Is(err, ErrInvalidStatus) {
+					t.Errorf("%s.Validate() error = %v, want %v", name, err, ErrInvalidStatus)
+				}
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
 
 	"github.com/vediagames/platform/game/domain"
 )
@@ -38,10 +40,16 @@ func (r eventRepository) Log(ctx context.Context, q domain.LogQuery) error {
 		VALUES ($1);
 	`, table)
 
-	res, err := r.db.Exec(sqlQuery, q.ID)
+	res, err := r.db.ExecContext(ctx, sqlQuery, q.ID)
 	if err != nil {
 		return fmt.Errorf("failed to execute: %w", err)
 	}
 
 	return handleModificationResults(res)
 }
+
+func rollback(ctx context.Context, tx *sqlx.Tx) {
+	if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		zerolog.Ctx(ctx).Error().Err(fmt.Errorf("failed to rollback: %w", err)).Send()
+	}
+}
@@ -0,0 +1,58 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+func (r repository) RecordPlay(ctx context.Context, q domain.RecordPlayQuery) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO public.game_play_history (game_id, visitor)
+		VALUES ($1, $2)
+		ON CONFLICT (game_id, visitor) DO UPDATE
+		SET plays = game_play_history.plays + 1, last_played_at = NOW()
+	`, q.ID, q.Visitor)
+	if err != nil {
+		return fmt.Errorf("failed to upsert: %w", err)
+	}
+
+	return nil
+}
+
+func (r repository) FindPlayHistory(ctx context.Context, q domain.FindPlayHistoryQuery) (domain.FindPlayHistoryResult, error) {
+	var rows []struct {
+		GameID       int       `db:"game_id"`
+		Plays        int       `db:"plays"`
+		LastPlayedAt time.Time `db:"last_played_at"`
+		Total        int       `db:"total"`
+	}
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT game_id, plays, last_played_at, COUNT(*) OVER() AS total
+		FROM public.game_play_history
+		WHERE visitor = $1
+		ORDER BY last_played_at DESC, game_id DESC
+		LIMIT $2 OFFSET $3
+	`, q.Visitor, q.Limit, (q.Page-1)*q.Limit)
+	if err != nil {
+		return domain.FindPlayHistoryResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindPlayHistoryResult{
+		Data: make([]domain.Play, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Total = row.Total
+		res.Data = append(res.Data, domain.Play{
+			ID:           row.GameID,
+			Plays:        row.Plays,
+			LastPlayedAt: row.LastPlayedAt,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,141 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+// sortKey is a value a listing is sorted by, type is what its text form in a
+// cursor is cast back to.
+type sortKey struct {
+	expr string
+	typ  string
+}
+
+// keyset is the order of a sorting method when paging by cursor. The ID comes
+// after the keys to break ties and follows their direction, so a whole row
+// can be compared with the cursor.
+type keyset struct {
+	keys []sortKey
+	desc bool
+	// snapshot marks keys read from the trending snapshot, which is replaced
+	// as a whole.
+	snapshot bool
+}
+
+var keysetOptions = map[domain.SortingMethod]keyset{
+	// A random order is replaced by a seeded hash of the ID, so it stays the
+	// same across pages.
+	domain.SortingMethodRandom:        {keys: []sortKey{{"MD5(CAST(id AS TEXT) || :seed)", "TEXT"}}},
+	domain.SortingMethodID:            {},
+	domain.SortingMethodName:          {keys: []sortKey{{"name", "TEXT"}}},
+	domain.SortingMethodNewest:        {keys: []sortKey{{"created_at", "TIMESTAMPTZ"}}, desc: true},
+	domain.SortingMethodOldest:        {keys: []sortKey{{"created_at", "TIMESTAMPTZ"}}},
+	domain.SortingMethodMostPopular:   {keys: []sortKey{{"plays", "INTEGER"}}, desc: true},
+	domain.SortingMethodLeastPopular:  {keys: []sortKey{{"plays", "INTEGER"}}},
+	domain.SortingMethodMostLiked:     {keys: []sortKey{{"likes", "INTEGER"}}, desc: true},
+	domain.SortingMethodLeastLiked:    {keys: []sortKey{{"likes", "INTEGER"}}},
+	domain.SortingMethodMostDisliked:  {keys: []sortKey{{"dislikes", "INTEGER"}}, desc: true},
+	domain.SortingMethodLeastDisliked: {keys: []sortKey{{"dislikes", "INTEGER"}}},
+	domain.SortingMethodTrending: {keys: []sortKey{
+		{`COALESCE((
+			SELECT t.score FROM public.game_trending_scores t WHERE t.game_id = id
+		), 0)`, "DOUBLE PRECISION"},
+		{"plays", "INTEGER"},
+	}, desc: true, snapshot: true},
+}
+
+func (k keyset) orderBy() string {
+	dir := "ASC"
+	if k.desc {
+		dir = "DESC"
+	}
+
+	var b strings.Builder
+
+	for _, key := range k.keys {
+		fmt.Fprintf(&b, "%s %s, ", key.expr, dir)
+	}
+
+	fmt.Fprintf(&b, "id %s", dir)
+
+	return b.String()
+}
+
+// sortKeys selects the keys of a row in their text form.
+func (k keyset) sortKeys() string {
+	exprs := make([]string, 0, len(k.keys))
+
+	for _, key := range k.keys {
+		exprs = append(exprs, fmt.Sprintf("CAST(%s AS TEXT)", key.expr))
+	}
+
+	return fmt.Sprintf("CAST(ARRAY[%s] AS TEXT[])", strings.Join(exprs, ", "))
+}
+
+// after filters the rows that follow the cursor, its values are bound by
+// args.
+func (k keyset) after() string {
+	exprs := make([]string, 0, len(k.keys)+1)
+	params := make([]string, 0, len(k.keys)+1)
+
+	for i, key := range k.keys {
+		exprs = append(exprs, key.expr)
+		params = append(params, fmt.Sprintf("CAST(:key_%d AS %s)", i, key.typ))
+	}
+
+	exprs = append(exprs, "id")
+	params = append(params, ":after_id")
+
+	op := ">"
+	if k.desc {
+		op = "<"
+	}
+
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(exprs, ", "), op, strings.Join(params, ", "))
+}
+
+func (k keyset) args(c domain.Cursor) (map[string]interface{}, error) {
+	if !c.IsZero() && len(c.Keys) != len(k.keys) {
+		return nil, fmt.Errorf("%w: has %d keys, want %d", domain.ErrInvalidCursor, len(c.Keys), len(k.keys))
+	}
+
+	args := map[string]interface{}{
+		"after_id": c.ID,
+		"seed":     c.Seed,
+	}
+
+	for i, key := range c.Keys {
+		args[fmt.Sprintf("key_%d", i)] = key
+	}
+
+	return args, nil
+}
+
+// newSeed returns the seed of a random order that starts on this page.
+func newSeed() string {
+	return strconv.FormatInt(rand.Int63(), 36)
+}
+
+// trendingSnapshot returns when the trending snapshot was computed in its text
+// form, or an empty string when there is none.
+func trendingSnapshot(ctx context.Context, q sqlx.QueryerContext) (string, error) {
+	var snapshot sql.NullString
+
+	err := sqlx.GetContext(ctx, q, &snapshot, `
+		SELECT CAST(MAX(computed_at) AS TEXT) FROM public.game_trending_scores
+	`)
+	if err != nil {
+		return "", fmt.Errorf("failed to get trending snapshot: %w", err)
+	}
+
+	return snapshot.String, nil
+}
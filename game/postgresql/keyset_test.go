@@ -0,0 +1,38 @@
+package postgresql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+func TestKeyset(t *testing.T) {
+	ks := keysetOptions[domain.SortingMethodMostPopular]
+
+	if got, want := ks.orderBy(), "plays DESC, id DESC"; got != want {
+		t.Errorf("orderBy() = %q, want %q", got, want)
+	}
+
+	if got, want := ks.after(), "(plays, id) < (CAST(:key_0 AS INTEGER), :after_id)"; got != want {
+		t.Errorf("after() = %q, want %q", got, want)
+	}
+
+	if got, want := keysetOptions[domain.SortingMethodID].after(), "(id) > (:after_id)"; got != want {
+		t.Errorf("after() = %q, want %q", got, want)
+	}
+
+	args, err := ks.args(domain.Cursor{Sort: domain.SortingMethodMostPopular, Keys: []string{"12"}, ID: 3})
+	if err != nil {
+		t.Fatalf("failed to get args: %s", err)
+	}
+
+	if args["key_0"] != "12" || args["after_id"] != 3 {
+		t.Errorf("args = %v, want key_0 12 and after_id 3", args)
+	}
+
+	_, err = ks.args(domain.Cursor{Sort: domain.SortingMethodMostPopular, ID: 3})
+	if !errors.Is(err, domain.ErrInvalidCursor) {
+		t.Errorf("args() error = %v, want %s", err, domain.ErrInvalidCursor)
+	}
+}
@@ -0,0 +1,321 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+type OutboxConfig struct {
+	DB            *sqlx.DB
+	Sinks         []domain.EventSink
+	BufferSize    int
+	BatchSize     int
+	FlushInterval time.Duration
+	// MaxAttempts is how many times a relay batch is retried before its
+	// events are marked as failed and left in the outbox for inspection.
+	MaxAttempts int
+}
+
+func (c OutboxConfig) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.DB == nil, fmt.Errorf("empty DB"))
+	err.AddIf(c.BufferSize < 1, fmt.Errorf("buffer size must be positive"))
+	err.AddIf(c.BatchSize < 1, fmt.Errorf("batch size must be positive"))
+	err.AddIf(c.FlushInterval <= 0, fmt.Errorf("flush interval must be positive"))
+	err.AddIf(c.MaxAttempts < 1, fmt.Errorf("max attempts must be positive"))
+
+	for i, sink := range c.Sinks {
+		err.AddIf(sink == nil, fmt.Errorf("empty sink at index %d", i))
+	}
+
+	return err.Err()
+}
+
+// Outbox is an EventRepository that buffers logged events in memory and
+// writes them in batches from Run. Each batch lands in the event tables and
+// in game_event_outbox within one transaction; Run then relays outbox rows
+// to the sinks and deletes them once every sink accepted them.
+//
+// Events still buffered when the process dies are lost. Once written, they
+// are delivered at least once.
+type Outbox struct {
+	db            *sqlx.DB
+	sinks         []domain.EventSink
+	batchSize     int
+	flushInterval time.Duration
+	maxAttempts   int
+	buffer        chan domain.LoggedEvent
+}
+
+func NewOutbox(cfg OutboxConfig) *Outbox {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &Outbox{
+		db:            cfg.DB,
+		sinks:         cfg.Sinks,
+		batchSize:     cfg.BatchSize,
+		flushInterval: cfg.FlushInterval,
+		maxAttempts:   cfg.MaxAttempts,
+		buffer:        make(chan domain.LoggedEvent, cfg.BufferSize),
+	}
+}
+
+// Log queues the event for the next flush. When the buffer is full the event
+// is written synchronously instead of being dropped.
+func (o *Outbox) Log(ctx context.Context, q domain.LogQuery) error {
+	if _, ok := logEventTables[q.Event]; !ok {
+		return fmt.Errorf("unknown event: %s", q.Event)
+	}
+
+	e := domain.LoggedEvent{
+		GameID:   q.ID,
+		Event:    q.Event,
+		LoggedAt: time.Now(),
+	}
+
+	select {
+	case o.buffer <- e:
+		return nil
+	default:
+	}
+
+	if err := o.insert(ctx, []domain.LoggedEvent{e}); err != nil {
+		return fmt.Errorf("failed to insert: %w", err)
+	}
+
+	return nil
+}
+
+// Run flushes buffered events and relays the outbox every flush interval
+// until ctx is done. Buffered events are flushed one last time on return.
+func (o *Outbox) Run(ctx context.Context) error {
+	ticker := time.NewTicker(o.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			o.flush(zerolog.Ctx(ctx).WithContext(flushCtx))
+
+			return nil
+		case <-ticker.C:
+			o.flush(ctx)
+
+			if err := o.relay(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to relay outbox")
+			}
+		}
+	}
+}
+
+func (o *Outbox) flush(ctx context.Context) {
+	for {
+		batch := o.drain()
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := o.insert(ctx, batch); err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to insert batch, inserting one by one")
+
+			// A single bad event (e.g. a game deleted meanwhile) must not
+			// take the rest of the batch down with it.
+			for _, e := range batch {
+				if err := o.insert(ctx, []domain.LoggedEvent{e}); err != nil {
+					zerolog.Ctx(ctx).Error().Err(err).
+						Int("game_id", e.GameID).
+						Str("event", e.Event.String()).
+						Msg("dropping event")
+				}
+			}
+		}
+
+		if len(batch) < o.batchSize {
+			return
+		}
+	}
+}
+
+func (o *Outbox) drain() []domain.LoggedEvent {
+	batch := make([]domain.LoggedEvent, 0, o.batchSize)
+
+	for len(batch) < o.batchSize {
+		select {
+		case e := <-o.buffer:
+			batch = append(batch, e)
+		default:
+			return batch
+		}
+	}
+
+	return batch
+}
+
+func (o *Outbox) insert(ctx context.Context, batch []domain.LoggedEvent) error {
+	tx, err := o.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	byTable := make(map[string][]domain.LoggedEvent, len(logEventTables))
+	for _, e := range batch {
+		table := logEventTables[e.Event]
+		byTable[table] = append(byTable[table], e)
+	}
+
+	for table, events := range byTable {
+		values, args := make([]string, 0, len(events)), make([]any, 0, len(events)*2)
+		for _, e := range events {
+			values = append(values, fmt.Sprintf("($%d, $%d)", len(args)+1, len(args)+2))
+			args = append(args, e.GameID, e.LoggedAt)
+		}
+
+		sqlQuery := fmt.Sprintf(`INSERT INTO %s (game_id, date) VALUES %s`, table, strings.Join(values, ", "))
+
+		if _, err := tx.ExecContext(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("failed to insert into %s: %w", table, err)
+		}
+	}
+
+	values, args := make([]string, 0, len(batch)), make([]any, 0, len(batch)*3)
+	for _, e := range batch {
+		values = append(values, fmt.Sprintf("($%d, $%d, $%d)", len(args)+1, len(args)+2, len(args)+3))
+		args = append(args, e.GameID, e.Event, e.LoggedAt)
+	}
+
+	sqlQuery := `INSERT INTO public.game_event_outbox (game_id, event, logged_at) VALUES ` + strings.Join(values, ", ")
+
+	if _, err := tx.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to insert into outbox: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Outbox) relay(ctx context.Context) error {
+	if len(o.sinks) == 0 {
+		return nil
+	}
+
+	for {
+		n, err := o.relayBatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		if n < o.batchSize {
+			return nil
+		}
+	}
+}
+
+// relayBatch keeps the claimed rows locked while sending, so concurrent
+// relays on other instances skip them instead of sending them twice.
+func (o *Outbox) relayBatch(ctx context.Context) (int, error) {
+	tx, err := o.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	var rows []struct {
+		ID       int64     `db:"id"`
+		GameID   int       `db:"game_id"`
+		Event    string    `db:"event"`
+		LoggedAt time.Time `db:"logged_at"`
+	}
+
+	err = tx.SelectContext(ctx, &rows, `
+		SELECT id, game_id, event, logged_at
+		FROM public.game_event_outbox
+		WHERE failed_at IS NULL AND next_attempt_at <= now()
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, o.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	var (
+		batch = make([]domain.LoggedEvent, 0, len(rows))
+		ids   = make([]int64, 0, len(rows))
+	)
+
+	for _, row := range rows {
+		batch = append(batch, domain.LoggedEvent{
+			ID:       row.ID,
+			GameID:   row.GameID,
+			Event:    domain.Event(row.Event),
+			LoggedAt: row.LoggedAt,
+		})
+		ids = append(ids, row.ID)
+	}
+
+	sendErr := o.send(ctx, batch)
+
+	if sendErr == nil {
+		_, err = tx.ExecContext(ctx, `DELETE FROM public.game_event_outbox WHERE id = ANY($1)`, pq.Array(ids))
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete: %w", err)
+		}
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE public.game_event_outbox
+			SET attempts = attempts + 1,
+				last_error = $2,
+				next_attempt_at = now() + interval '1 second' * power(2, LEAST(attempts, 10)),
+				failed_at = CASE WHEN attempts + 1 >= $3 THEN now() END
+			WHERE id = ANY($1)
+		`, pq.Array(ids), sendErr.Error(), o.maxAttempts)
+		if err != nil {
+			return 0, fmt.Errorf("failed to schedule retry: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	if sendErr != nil {
+		return 0, fmt.Errorf("failed to send: %w", sendErr)
+	}
+
+	return len(rows), nil
+}
+
+func (o *Outbox) send(ctx context.Context, batch []domain.LoggedEvent) error {
+	var err zeroerror.Error
+
+	for i, sink := range o.sinks {
+		if sendErr := sink.Send(ctx, batch); sendErr != nil {
+			err.Add(fmt.Errorf("sink %d: %w", i, sendErr))
+		}
+	}
+
+	return err.Err()
+}
@@ -0,0 +1,196 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+func (r repository) Restore(ctx context.Context, q domain.RestoreQuery) (domain.RestoreResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.RestoreResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	var row struct {
+		ID     int    `db:"id"`
+		Status string `db:"status"`
+	}
+
+	err = tx.GetContext(ctx, &row, `
+		SELECT id, status
+		FROM public.games
+		WHERE ($1 != 0 AND id = $1) OR ($1 = 0 AND slug = $2)
+		FOR UPDATE
+	`, q.ID, q.Slug)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.RestoreResult{}, domain.ErrNoData
+	}
+	if err != nil {
+		return domain.RestoreResult{}, fmt.Errorf("failed to lock game: %w", err)
+	}
+
+	if domain.Status(row.Status) != domain.StatusDeleted {
+		return domain.RestoreResult{}, fmt.Errorf("%w: game is %s", domain.ErrInvalidStatus, row.Status)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE public.games SET status = 'invisible', deleted_at = NULL WHERE id = $1
+	`, row.ID)
+	if err != nil {
+		return domain.RestoreResult{}, fmt.Errorf("failed to update: %w", err)
+	}
+
+	if err := insertRevision(ctx, tx, row.ID, domain.RevisionActionRestore, q.Author); err != nil {
+		return domain.RestoreResult{}, fmt.Errorf("failed to insert revision: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.RestoreResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	repoRes, err := r.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    row.ID,
+		Language: domain.LanguageEnglish,
+	})
+	if err != nil {
+		return domain.RestoreResult{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	return domain.RestoreResult(repoRes), nil
+}
+
+func (r repository) FindDeleted(ctx context.Context, q domain.FindDeletedQuery) (domain.FindDeletedResult, error) {
+	var rows []struct {
+		ID        int         `db:"id"`
+		Slug      string      `db:"slug"`
+		DeletedAt pq.NullTime `db:"deleted_at"`
+	}
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT id, slug, deleted_at
+		FROM public.games
+		WHERE status = 'deleted'
+			AND (
+				(cardinality($1::INT[]) > 0 AND id = ANY($1))
+				OR (cardinality($1::INT[]) = 0 AND deleted_at < $2)
+			)
+		ORDER BY id
+	`, pq.Array(q.IDs), nullTime(q.DeletedBefore))
+	if err != nil {
+		return domain.FindDeletedResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindDeletedResult{
+		Data: make([]domain.DeletedGame, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Data = append(res.Data, domain.DeletedGame{
+			ID:        row.ID,
+			Slug:      row.Slug,
+			DeletedAt: row.DeletedAt.Time,
+		})
+	}
+
+	return res, nil
+}
+
+// purgedTables are the tables holding rows of a game, deleted in this order
+// before the game itself. Tables whose foreign key cascades are listed too,
+// so that the report counts their rows.
+var purgedTables = []struct {
+	table  string
+	column string
+}{
+	{"game_texts", "game_id"},
+	{"game_tags", "game_id"},
+	{"game_categories", "game_id"},
+	{"game_play_events", "game_id"},
+	{"game_like_events", "game_id"},
+	{"game_dislike_events", "game_id"},
+	{"game_event_outbox", "game_id"},
+	{"game_reactions", "game_id"},
+	{"game_play_history", "game_id"},
+	{"game_trending_scores", "game_id"},
+	{"game_revisions", "game_id"},
+	{"game_slug_history", "game_id"},
+	{"game_schedules", "game_id"},
+	{"game_liveness", "game_id"},
+	{"game_providers", "game_id"},
+	{"section_games", "game_id"},
+	{"list_games", "game_slug"},
+}
+
+func (r repository) Purge(ctx context.Context, q domain.PurgeQuery) (domain.PurgeResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.PurgeResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	var row struct {
+		Slug   string `db:"slug"`
+		Status string `db:"status"`
+	}
+
+	err = tx.GetContext(ctx, &row, `
+		SELECT slug, status FROM public.games WHERE id = $1 FOR UPDATE
+	`, q.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.PurgeResult{}, domain.ErrNoData
+	}
+	if err != nil {
+		return domain.PurgeResult{}, fmt.Errorf("failed to lock game: %w", err)
+	}
+
+	if domain.Status(row.Status) != domain.StatusDeleted {
+		return domain.PurgeResult{}, fmt.Errorf("%w: game is %s", domain.ErrInvalidStatus, row.Status)
+	}
+
+	res := domain.PurgeResult{
+		Rows: make(map[string]int, len(purgedTables)+1),
+	}
+
+	for _, t := range purgedTables {
+		var arg any = q.ID
+		if t.column == "game_slug" {
+			arg = row.Slug
+		}
+
+		sqlRes, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM public.%s WHERE %s = $1`, t.table, t.column), arg)
+		if err != nil {
+			return domain.PurgeResult{}, fmt.Errorf("failed to delete from %s: %w", t.table, err)
+		}
+
+		n, err := sqlRes.RowsAffected()
+		if err != nil {
+			return domain.PurgeResult{}, fmt.Errorf("failed to get rows affected of %s: %w", t.table, err)
+		}
+
+		res.Rows[t.table] = int(n)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM public.games WHERE id = $1`, q.ID); err != nil {
+		return domain.PurgeResult{}, fmt.Errorf("failed to delete game: %w", err)
+	}
+
+	res.Rows["games"] = 1
+
+	if q.DryRun {
+		return res, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.PurgeResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,133 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+// React locks the game row first, so reactions to one game are applied one
+// after another and the counters always match game_reactions.
+func (r repository) React(ctx context.Context, q domain.ReactQuery) (domain.ReactResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.ReactResult{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	var locked int
+
+	err = tx.GetContext(ctx, &locked, `
+		SELECT id
+		FROM public.games
+		WHERE id = $1
+		FOR UPDATE
+	`, q.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ReactResult{}, domain.ErrNoData
+	}
+	if err != nil {
+		return domain.ReactResult{}, fmt.Errorf("failed to lock game: %w", err)
+	}
+
+	previous := domain.ReactionNone
+
+	err = tx.GetContext(ctx, &previous, `
+		SELECT reaction
+		FROM public.game_reactions
+		WHERE game_id = $1 AND visitor = $2
+	`, q.ID, q.Visitor)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return domain.ReactResult{}, fmt.Errorf("failed to get previous reaction: %w", err)
+	}
+
+	if q.Reaction == domain.ReactionNone {
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM public.game_reactions
+			WHERE game_id = $1 AND visitor = $2
+		`, q.ID, q.Visitor)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO public.game_reactions (game_id, visitor, reaction)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (game_id, visitor) DO UPDATE
+			SET reaction = EXCLUDED.reaction, updated_at = NOW()
+		`, q.ID, q.Visitor, q.Reaction)
+	}
+	if err != nil {
+		return domain.ReactResult{}, fmt.Errorf("failed to store reaction: %w", err)
+	}
+
+	likes, dislikes := reactionDelta(previous, q.Reaction)
+
+	res := domain.ReactResult{
+		Previous: previous,
+	}
+
+	err = tx.QueryRowxContext(ctx, `
+		UPDATE public.games
+		SET
+			likes = GREATEST(likes + $2, 0),
+			dislikes = GREATEST(dislikes + $3, 0)
+		WHERE id = $1
+		RETURNING likes, dislikes
+	`, q.ID, likes, dislikes).Scan(&res.Likes, &res.Dislikes)
+	if err != nil {
+		return domain.ReactResult{}, fmt.Errorf("failed to update counters: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.ReactResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return res, nil
+}
+
+func reactionDelta(from, to domain.Reaction) (likes, dislikes int) {
+	count := func(r domain.Reaction) (int, int) {
+		switch r {
+		case domain.ReactionLike:
+			return 1, 0
+		case domain.ReactionDislike:
+			return 0, 1
+		}
+
+		return 0, 0
+	}
+
+	fromLikes, fromDislikes := count(from)
+	toLikes, toDislikes := count(to)
+
+	return toLikes - fromLikes, toDislikes - fromDislikes
+}
+
+func (r repository) FindReactions(ctx context.Context, q domain.FindReactionsQuery) (domain.FindReactionsResult, error) {
+	var rows []struct {
+		GameID   int             `db:"game_id"`
+		Reaction domain.Reaction `db:"reaction"`
+	}
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT game_id, reaction
+		FROM public.game_reactions
+		WHERE visitor = $1 AND game_id = ANY($2)
+	`, q.Visitor, pq.Array(q.IDs))
+	if err != nil {
+		return domain.FindReactionsResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindReactionsResult{
+		Data: make(map[int]domain.Reaction, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Data[row.GameID] = row.Reaction
+	}
+
+	return res, nil
+}
@@ -0,0 +1,30 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+func TestReactionDelta(t *testing.T) {
+	tests := []struct {
+		from, to               domain.Reaction
+		wantLikes, wantDislike int
+	}{
+		{domain.ReactionNone, domain.ReactionLike, 1, 0},
+		{domain.ReactionLike, domain.ReactionLike, 0, 0},
+		{domain.ReactionLike, domain.ReactionNone, -1, 0},
+		{domain.ReactionLike, domain.ReactionDislike, -1, 1},
+		{domain.ReactionDislike, domain.ReactionLike, 1, -1},
+		{domain.ReactionDislike, domain.ReactionNone, 0, -1},
+		{domain.ReactionNone, domain.ReactionNone, 0, 0},
+	}
+
+	for _, tt := range tests {
+		likes, dislikes := reactionDelta(tt.from, tt.to)
+		if likes != tt.wantLikes || dislikes != tt.wantDislike {
+			t.Errorf("reactionDelta(%s, %s) = %d, %d, want %d, %d",
+				tt.from, tt.to, likes, dislikes, tt.wantLikes, tt.wantDislike)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"text/template"
@@ -52,6 +53,11 @@ func (r repository) Insert(ctx context.Context, q domain.InsertQuery) (domain.In
 	if err != nil {
 		return domain.InsertResult{}, fmt.Errorf("failed to begin tx: %w", err)
 	}
+	defer rollback(ctx, tx)
+
+	if err = keepSlug(ctx, tx, 0, q.Slug); err != nil {
+		return domain.InsertResult{}, err
+	}
 
 	var gameID int
 
@@ -119,6 +125,10 @@ func (r repository) Insert(ctx context.Context, q domain.InsertQuery) (domain.In
 		}
 	}
 
+	if err = insertRevision(ctx, tx, gameID, domain.RevisionActionCreate, q.Author); err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to insert revision: %w", err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return domain.InsertResult{}, fmt.Errorf("failed to commit: %w", err)
 	}
@@ -146,6 +156,10 @@ func (r repository) Update(ctx context.Context, q domain.UpdateQuery) (domain.Up
 		}
 	}()
 
+	if err = keepSlug(ctx, tx, q.ID, q.Slug); err != nil {
+		return domain.UpdateResult{}, err
+	}
+
 	_, err = tx.ExecContext(ctx, `
 		UPDATE games
 		SET
@@ -155,16 +169,17 @@ func (r repository) Update(ctx context.Context, q domain.UpdateQuery) (domain.Up
 			url = $4,
 			width = $5,
 			height= $6,
-			likes = $7,
-			dislikes = $8,
-			plays = $9,
-			weight = $10
-		WHERE id = $11
-	`, q.Slug, q.Mobile, q.Status.String(), q.URL, q.Width, q.Height, q.Likes, q.Dislikes, q.Plays, q.Weight, q.ID)
+			weight = $7
+		WHERE id = $8
+	`, q.Slug, q.Mobile, q.Status.String(), q.URL, q.Width, q.Height, q.Weight, q.ID)
 	if err != nil {
 		return domain.UpdateResult{}, fmt.Errorf("failed to update: %w", err)
 	}
 
+	if err = dropPendingSchedule(ctx, tx, q.ID, q.Status); err != nil {
+		return domain.UpdateResult{}, err
+	}
+
 	_, err = tx.ExecContext(ctx, "DELETE FROM game_tags WHERE game_id = $1", q.ID)
 	if err != nil {
 		return domain.UpdateResult{}, fmt.Errorf("failed to delete tags: %w", err)
@@ -206,6 +221,10 @@ func (r repository) Update(ctx context.Context, q domain.UpdateQuery) (domain.Up
 		}
 	}
 
+	if err = insertRevision(ctx, tx, q.ID, domain.RevisionActionEdit, q.Author); err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to insert revision: %w", err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return domain.UpdateResult{}, fmt.Errorf("failed to commit: %w", err)
 	}
@@ -231,25 +250,35 @@ func (r repository) Delete(ctx context.Context, q domain.DeleteQuery) (domain.De
 
 	switch {
 	case q.ID != 0:
-		query = "UPDATE games SET status = 'deleted', deleted_at = NOW() WHERE id = $1"
+		query = "UPDATE games SET status = 'deleted', deleted_at = NOW() WHERE id = $1 RETURNING id"
 		arg = q.ID
 	case q.Slug != "":
-		query = "UPDATE games SET status = 'deleted', deleted_at = NOW() WHERE slug = $1"
+		query = "UPDATE games SET status = 'deleted', deleted_at = NOW() WHERE slug = $1 RETURNING id"
 		arg = q.Slug
 	}
 
-	res, err := r.db.ExecContext(ctx, query, arg)
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return domain.DeleteResult{}, fmt.Errorf("failed to exec: %w", err)
+		return domain.DeleteResult{}, fmt.Errorf("failed to begin tx: %w", err)
 	}
+	defer rollback(ctx, tx)
 
-	rowsAffected, err := res.RowsAffected()
+	var gameID int
+
+	err = tx.GetContext(ctx, &gameID, query, arg)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DeleteResult{}, fmt.Errorf("no rows found matching the provided ID or Slug")
+	}
 	if err != nil {
-		return domain.DeleteResult{}, fmt.Errorf("failed to get rows affected: %w", err)
+		return domain.DeleteResult{}, fmt.Errorf("failed to exec: %w", err)
 	}
 
-	if rowsAffected == 0 {
-		return domain.DeleteResult{}, fmt.Errorf("no rows found matching the provided ID or Slug")
+	if err = insertRevision(ctx, tx, gameID, domain.RevisionActionRemove, q.Author); err != nil {
+		return domain.DeleteResult{}, fmt.Errorf("failed to insert revision: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.DeleteResult{}, fmt.Errorf("failed to commit: %w", err)
 	}
 
 	return domain.DeleteResult{}, nil
@@ -258,9 +287,84 @@ func (r repository) Delete(ctx context.Context, q domain.DeleteQuery) (domain.De
 func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindResult, error) {
 	val := orderByOptions[q.Sort]
 
+	ks, isKeyset := keysetOptions[q.Sort]
+	isKeyset = isKeyset && q.First > 0
+
+	if q.First > 0 && !isKeyset {
+		return domain.FindResult{}, fmt.Errorf("%w: %q", domain.ErrInvalidSortingMethod, q.Sort)
+	}
+
+	args := map[string]interface{}{
+		"language_code":     q.Language.String(),
+		"limit":             q.Limit,
+		"offset":            (q.Page - 1) * q.Limit,
+		"category_id_refs":  pq.Array(q.CategoryIDRefs),
+		"tag_id_refs":       pq.Array(q.TagIDRefs),
+		"id_refs":           q.IDRefs,
+		"excluded_id_refs":  q.ExcludedIDRefs,
+		"create_date_limit": q.CreateDateLimit,
+		"slugs":             q.Slugs,
+	}
+
+	seed := q.After.Seed
+
+	var (
+		querier  sqlx.QueryerContext = r.db
+		snapshot string
+	)
+
+	// The snapshot is read in the same transaction as the page, so it cannot
+	// be replaced in between.
+	if isKeyset && ks.snapshot {
+		tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+		if err != nil {
+			return domain.FindResult{}, fmt.Errorf("failed to begin tx: %w", err)
+		}
+		defer rollback(ctx, tx)
+
+		snapshot, err = trendingSnapshot(ctx, tx)
+		if err != nil {
+			return domain.FindResult{}, err
+		}
+
+		if !q.After.IsZero() && q.After.Snapshot != snapshot {
+			return domain.FindResult{}, fmt.Errorf("%w: trending snapshot was replaced", domain.ErrInvalidCursor)
+		}
+
+		querier = tx
+	}
+
+	if isKeyset {
+		if q.Sort == domain.SortingMethodRandom && seed == "" {
+			if !q.After.IsZero() {
+				return domain.FindResult{}, fmt.Errorf("%w: random order without seed", domain.ErrInvalidCursor)
+			}
+
+			seed = newSeed()
+		}
+
+		keysetArgs, err := ks.args(q.After)
+		if err != nil {
+			return domain.FindResult{}, err
+		}
+
+		for k, v := range keysetArgs {
+			args[k] = v
+		}
+
+		val = ks.orderBy()
+		args["seed"] = seed
+		// One more than asked tells if there is a next page.
+		args["limit"] = q.First + 1
+	}
+
 	sqlQuery, err := templateToSQL(
 		"find_game",
 		templateQuery{
+			"Keyset":                 isKeyset,
+			"SortKeys":               ks.sortKeys(),
+			"After":                  !q.After.IsZero(),
+			"AfterCursor":            ks.after(),
 			"OrderBy":                val,
 			"FilterByCategoryIDRefs": len(q.CategoryIDRefs) > 0,
 			"FilterByTagIDRefs":      len(q.TagIDRefs) > 0,
@@ -297,7 +401,11 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 					player_2_controls,
 					tag_id_refs,
 					category_id_refs,
+				{{ if .Keyset }}
+					{{ .SortKeys }} AS sort_keys
+				{{ else }}
 					COUNT(*) OVER() AS total_count
+				{{ end }}
 				FROM public.games_view
 				WHERE language_code = :language_code
 				{{ if .FilterByCategoryIDRefs }}
@@ -324,33 +432,31 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 				{{ if not .AllowInvisible }}
 					AND status != 'invisible'
 				{{ end }}
+				-- Scheduled games are never listed, whatever the query
+				-- allows, until the scheduler publishes them.
+				AND status != 'scheduled'
 				{{ if .MobileOnly }}
 					AND mobile = true
 				{{ end }}
+				{{ if and .Keyset .After }}
+					AND {{ .AfterCursor }}
+				{{ end }}
 				ORDER BY {{ .OrderBy }}
 				LIMIT :limit
-				OFFSET :offset;
+				{{ if not .Keyset }}
+					OFFSET :offset
+				{{ end }};
 	`)
 	if err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to template sql: %v", err)
 	}
 
-	query, args, err := sqlx.Named(sqlQuery, map[string]interface{}{
-		"language_code":     q.Language.String(),
-		"limit":             q.Limit,
-		"offset":            (q.Page - 1) * q.Limit,
-		"category_id_refs":  pq.Array(q.CategoryIDRefs),
-		"tag_id_refs":       pq.Array(q.TagIDRefs),
-		"id_refs":           q.IDRefs,
-		"excluded_id_refs":  q.ExcludedIDRefs,
-		"create_date_limit": q.CreateDateLimit,
-		"slugs":             q.Slugs,
-	})
+	query, queryArgs, err := sqlx.Named(sqlQuery, args)
 	if err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to generate named: %w", err)
 	}
 
-	query, args, err = sqlx.In(query, args...)
+	query, queryArgs, err = sqlx.In(query, queryArgs...)
 	if err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to expand %w", err)
 	}
@@ -359,7 +465,7 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 
 	var sqlRes []gameWithTotalCount
 
-	if err := r.db.Select(&sqlRes, query, args...); err != nil {
+	if err := sqlx.SelectContext(ctx, querier, &sqlRes, query, queryArgs...); err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to select %w", err)
 	}
 
@@ -370,6 +476,11 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 		},
 	}
 
+	if isKeyset && len(sqlRes) > q.First {
+		sqlRes = sqlRes[:q.First]
+		res.HasNextPage = true
+	}
+
 	if len(sqlRes) > 0 {
 		res.Data.Total = sqlRes[0].TotalCount
 	}
@@ -381,6 +492,16 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 		}
 
 		res.Data.Data = append(res.Data.Data, gg)
+
+		if isKeyset {
+			res.Cursors = append(res.Cursors, domain.Cursor{
+				Sort:     q.Sort,
+				Keys:     g.SortKeys,
+				ID:       g.ID,
+				Seed:     seed,
+				Snapshot: snapshot,
+			})
+		}
 	}
 
 	return res, nil
@@ -472,6 +593,66 @@ func (r repository) IncreaseField(ctx context.Context, q domain.IncreaseFieldQue
 	return handleModificationResults(sqlRes)
 }
 
+// IncreaseFields applies all deltas with a single UPDATE. Deltas for games
+// that no longer exist are ignored.
+func (r repository) IncreaseFields(ctx context.Context, q domain.IncreaseFieldsQuery) error {
+	type counters struct {
+		plays, likes, dislikes int
+	}
+
+	var (
+		ids    []int
+		byGame = make(map[int]*counters)
+	)
+
+	for _, d := range q.Deltas {
+		c, ok := byGame[d.ID]
+		if !ok {
+			c = &counters{}
+			byGame[d.ID] = c
+			ids = append(ids, d.ID)
+		}
+
+		switch d.Field {
+		case domain.IncreaseFieldPlays:
+			c.plays += d.ByAmount
+		case domain.IncreaseFieldLikes:
+			c.likes += d.ByAmount
+		case domain.IncreaseFieldDislikes:
+			c.dislikes += d.ByAmount
+		default:
+			return fmt.Errorf("unsupported increasable field: %q", d.Field)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	values, args := make([]string, 0, len(ids)), make([]any, 0, len(ids)*4)
+	for _, id := range ids {
+		c := byGame[id]
+		values = append(values, fmt.Sprintf("($%d::int, $%d::int, $%d::int, $%d::int)",
+			len(args)+1, len(args)+2, len(args)+3, len(args)+4))
+		args = append(args, id, c.plays, c.likes, c.dislikes)
+	}
+
+	sqlQuery := fmt.Sprintf(`
+		UPDATE public.games g
+		SET plays = g.plays + v.plays,
+			likes = g.likes + v.likes,
+			dislikes = g.dislikes + v.dislikes
+		FROM (VALUES %s) AS v(id, plays, likes, dislikes)
+		WHERE g.id = v.id;
+	`, strings.Join(values, ", "))
+
+	if _, err := r.db.ExecContext(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to execute: %w", err)
+	}
+
+	return nil
+}
+
 func handleModificationResults(res sql.Result) error {
 	rows, err := res.RowsAffected()
 	if err != nil {
@@ -502,6 +683,8 @@ func templateToSQL(name string, tq templateQuery, tmpl string) (string, error) {
 type gameWithTotalCount struct {
 	game
 	TotalCount int `db:"total_count"`
+	// SortKeys are only selected when paging by cursor.
+	SortKeys pq.StringArray `db:"sort_keys"`
 }
 
 type templateQuery map[string]any
@@ -573,6 +756,7 @@ func (r repository) search(ctx context.Context, q searchQuery) (searchResult, er
 			{{ if not .AllowInvisible }}
 				AND status != 'invisible'
 			{{ end }}
+			AND status != 'scheduled'
 			ORDER BY (length(name) - levenshtein($1,name)) DESC;
 	`)
 	if err != nil {
@@ -690,6 +874,7 @@ func (r repository) FullSearch(ctx context.Context, q domain.FullSearchQuery) (d
 			{{ if not .AllowInvisible }}
 				AND status != 'invisible'
 			{{ end }}
+			AND status != 'scheduled'
 			{{- if .ShouldOrderBy }}
 			ORDER BY {{ .OrderBy }}
 			{{ end -}}
@@ -762,6 +947,7 @@ func (r repository) FindMostPlayedIDsByDate(ctx context.Context, q domain.FindMo
 			{{ if not .AllowInvisible }}
 				AND g.status != 'invisible'
 			{{ end }}
+			AND g.status != 'scheduled'
 			GROUP BY game_id
 			ORDER BY plays DESC
 			LIMIT $2 OFFSET $3;
@@ -861,6 +1047,10 @@ var orderByOptions = map[domain.SortingMethod]string{
 	domain.SortingMethodLeastLiked:    "likes ASC",
 	domain.SortingMethodMostDisliked:  "dislikes DESC",
 	domain.SortingMethodLeastDisliked: "dislikes ASC",
+	// Games that are not in the trending snapshot follow by plays.
+	domain.SortingMethodTrending: `COALESCE((
+		SELECT t.score FROM public.game_trending_scores t WHERE t.game_id = id
+	), 0) DESC, plays DESC`,
 }
 
 var langIDMap = map[domain.Language]int{
@@ -0,0 +1,478 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+// snapshot is how domain.Snapshot is stored in game_revisions.
+type snapshot struct {
+	Slug           string           `json:"slug"`
+	Mobile         bool             `json:"mobile"`
+	TagIDRefs      []int            `json:"tagIDRefs"`
+	CategoryIDRefs []int            `json:"categoryIDRefs"`
+	Status         string           `json:"status"`
+	URL            string           `json:"url"`
+	Width          int              `json:"width"`
+	Height         int              `json:"height"`
+	Weight         int              `json:"weight"`
+	Texts          map[string]texts `json:"texts"`
+}
+
+type texts struct {
+	Name             string `json:"name"`
+	ShortDescription string `json:"shortDescription"`
+	Description      string `json:"description"`
+	Content          string `json:"content"`
+	Player1Controls  string `json:"player1Controls"`
+	Player2Controls  string `json:"player2Controls"`
+}
+
+func (s snapshot) toDomain() domain.Snapshot {
+	res := domain.Snapshot{
+		Slug:           s.Slug,
+		Mobile:         s.Mobile,
+		TagIDRefs:      s.TagIDRefs,
+		CategoryIDRefs: s.CategoryIDRefs,
+		Status:         domain.Status(s.Status),
+		URL:            s.URL,
+		Width:          s.Width,
+		Height:         s.Height,
+		Weight:         s.Weight,
+		Texts:          make(map[domain.Language]domain.Texts, len(s.Texts)),
+	}
+
+	for l, t := range s.Texts {
+		res.Texts[domain.Language(l)] = domain.Texts(t)
+	}
+
+	return res
+}
+
+func snapshotFromDomain(s domain.Snapshot) snapshot {
+	res := snapshot{
+		Slug:           s.Slug,
+		Mobile:         s.Mobile,
+		TagIDRefs:      s.TagIDRefs,
+		CategoryIDRefs: s.CategoryIDRefs,
+		Status:         s.Status.String(),
+		URL:            s.URL,
+		Width:          s.Width,
+		Height:         s.Height,
+		Weight:         s.Weight,
+		Texts:          make(map[string]texts, len(s.Texts)),
+	}
+
+	for l, t := range s.Texts {
+		res.Texts[l.String()] = texts(t)
+	}
+
+	return res
+}
+
+type revision struct {
+	GameID        int            `db:"game_id"`
+	Number        int            `db:"number"`
+	Action        string         `db:"action"`
+	Author        string         `db:"author"`
+	CreatedAt     time.Time      `db:"created_at"`
+	ChangedFields pq.StringArray `db:"changed_fields"`
+	Snapshot      []byte         `db:"snapshot"`
+	TotalCount    int            `db:"total_count"`
+}
+
+func (r revision) toDomain() (domain.Revision, error) {
+	var s snapshot
+	if err := json.Unmarshal(r.Snapshot, &s); err != nil {
+		return domain.Revision{}, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	return domain.Revision{
+		GameID:        r.GameID,
+		Number:        r.Number,
+		Action:        domain.RevisionAction(r.Action),
+		Author:        r.Author,
+		CreatedAt:     r.CreatedAt,
+		ChangedFields: r.ChangedFields,
+		Snapshot:      s.toDomain(),
+	}, nil
+}
+
+var langCodeMap = map[int]domain.Language{
+	1: domain.LanguageEnglish,
+	2: domain.LanguageEspanol,
+}
+
+// loadSnapshot reads the current state of the game within the transaction,
+// so it includes the changes the transaction made.
+func loadSnapshot(ctx context.Context, tx *sqlx.Tx, id int) (domain.Snapshot, error) {
+	var row struct {
+		Slug   string `db:"slug"`
+		Mobile bool   `db:"mobile"`
+		Status string `db:"status"`
+		URL    string `db:"url"`
+		Width  int    `db:"width"`
+		Height int    `db:"height"`
+		Weight int    `db:"weight"`
+	}
+
+	err := tx.GetContext(ctx, &row, `
+		SELECT slug, mobile, status, url, width, height, weight
+		FROM public.games
+		WHERE id = $1
+	`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Snapshot{}, domain.ErrNoData
+	}
+	if err != nil {
+		return domain.Snapshot{}, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	res := domain.Snapshot{
+		Slug:   row.Slug,
+		Mobile: row.Mobile,
+		Status: domain.Status(row.Status),
+		URL:    row.URL,
+		Width:  row.Width,
+		Height: row.Height,
+		Weight: row.Weight,
+		Texts:  make(map[domain.Language]domain.Texts),
+	}
+
+	err = tx.SelectContext(ctx, &res.TagIDRefs, `
+		SELECT tag_id FROM public.game_tags WHERE game_id = $1 ORDER BY tag_id
+	`, id)
+	if err != nil {
+		return domain.Snapshot{}, fmt.Errorf("failed to select tags: %w", err)
+	}
+
+	err = tx.SelectContext(ctx, &res.CategoryIDRefs, `
+		SELECT category_id FROM public.game_categories WHERE game_id = $1 ORDER BY category_id
+	`, id)
+	if err != nil {
+		return domain.Snapshot{}, fmt.Errorf("failed to select categories: %w", err)
+	}
+
+	var textRows []struct {
+		LanguageID       int    `db:"language_id"`
+		Name             string `db:"name"`
+		ShortDescription string `db:"short_description"`
+		Description      string `db:"description"`
+		Content          string `db:"content"`
+		Player1Controls  string `db:"player_1_controls"`
+		Player2Controls  string `db:"player_2_controls"`
+	}
+
+	err = tx.SelectContext(ctx, &textRows, `
+		SELECT
+			language_id,
+			name,
+			short_description,
+			description,
+			COALESCE(content, '') AS content,
+			COALESCE(player_1_controls, '') AS player_1_controls,
+			COALESCE(player_2_controls, '') AS player_2_controls
+		FROM public.game_texts
+		WHERE game_id = $1
+	`, id)
+	if err != nil {
+		return domain.Snapshot{}, fmt.Errorf("failed to select texts: %w", err)
+	}
+
+	for _, t := range textRows {
+		lang, ok := langCodeMap[t.LanguageID]
+		if !ok {
+			continue
+		}
+
+		res.Texts[lang] = domain.Texts{
+			Name:             t.Name,
+			ShortDescription: t.ShortDescription,
+			Description:      t.Description,
+			Content:          t.Content,
+			Player1Controls:  t.Player1Controls,
+			Player2Controls:  t.Player2Controls,
+		}
+	}
+
+	return res, nil
+}
+
+// insertRevision records the state of the game as changed by the
+// transaction. Writers of a game hold its row lock, so revisions of one game
+// are numbered in the order they were committed.
+func insertRevision(ctx context.Context, tx *sqlx.Tx, id int, action domain.RevisionAction, author string) error {
+	current, err := loadSnapshot(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("failed to load snapshot: %w", err)
+	}
+
+	var previous domain.Snapshot
+
+	var last revision
+
+	err = tx.GetContext(ctx, &last, `
+		SELECT game_id, number, action, author, created_at, changed_fields, snapshot
+		FROM public.game_revisions
+		WHERE game_id = $1
+		ORDER BY number DESC
+		LIMIT 1
+	`, id)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return fmt.Errorf("failed to get last revision: %w", err)
+	default:
+		lastRevision, err := last.toDomain()
+		if err != nil {
+			return fmt.Errorf("failed to convert last revision: %w", err)
+		}
+
+		previous = lastRevision.Snapshot
+	}
+
+	changes := previous.Diff(current)
+
+	changedFields := make([]string, 0, len(changes))
+	for _, c := range changes {
+		changedFields = append(changedFields, c.Field)
+	}
+
+	data, err := json.Marshal(snapshotFromDomain(current))
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	if author == "" {
+		author = domain.AuthorSystem
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO public.game_revisions (game_id, number, action, author, changed_fields, snapshot)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, id, last.Number+1, action.String(), author, pq.Array(changedFields), data)
+	if err != nil {
+		return fmt.Errorf("failed to insert: %w", err)
+	}
+
+	return nil
+}
+
+func (r repository) FindRevisions(ctx context.Context, q domain.FindRevisionsQuery) (domain.FindRevisionsResult, error) {
+	var rows []revision
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT
+			game_id,
+			number,
+			action,
+			author,
+			created_at,
+			changed_fields,
+			snapshot,
+			COUNT(*) OVER() AS total_count
+		FROM public.game_revisions
+		WHERE game_id = $1
+		ORDER BY number DESC
+		LIMIT $2 OFFSET $3
+	`, q.ID, q.Limit, (q.Page-1)*q.Limit)
+	if err != nil {
+		return domain.FindRevisionsResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindRevisionsResult{
+		Data: make([]domain.Revision, 0, len(rows)),
+	}
+
+	for i, row := range rows {
+		rev, err := row.toDomain()
+		if err != nil {
+			return domain.FindRevisionsResult{}, fmt.Errorf("failed to convert to domain at index %d: %w", i, err)
+		}
+
+		res.Data = append(res.Data, rev)
+		res.Total = row.TotalCount
+	}
+
+	return res, nil
+}
+
+func (r repository) FindRevision(ctx context.Context, q domain.FindRevisionQuery) (domain.FindRevisionResult, error) {
+	rev, err := findRevision(ctx, r.db, q.ID, q.Number)
+	if err != nil {
+		return domain.FindRevisionResult{}, err
+	}
+
+	return domain.FindRevisionResult{
+		Data: rev,
+	}, nil
+}
+
+func findRevision(ctx context.Context, db sqlx.QueryerContext, id, number int) (domain.Revision, error) {
+	var row revision
+
+	err := sqlx.GetContext(ctx, db, &row, `
+		SELECT game_id, number, action, author, created_at, changed_fields, snapshot
+		FROM public.game_revisions
+		WHERE game_id = $1 AND number = $2
+	`, id, number)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Revision{}, domain.ErrNoData
+	}
+	if err != nil {
+		return domain.Revision{}, fmt.Errorf("failed to get: %w", err)
+	}
+
+	rev, err := row.toDomain()
+	if err != nil {
+		return domain.Revision{}, fmt.Errorf("failed to convert to domain: %w", err)
+	}
+
+	return rev, nil
+}
+
+// Rollback keeps the counters, they are not part of a snapshot. Texts of
+// languages the snapshot does not have are left as they are.
+func (r repository) Rollback(ctx context.Context, q domain.RollbackQuery) (domain.RollbackResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.RollbackResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	var locked int
+
+	err = tx.GetContext(ctx, &locked, `
+		SELECT id FROM public.games WHERE id = $1 FOR UPDATE
+	`, q.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.RollbackResult{}, domain.ErrNoData
+	}
+	if err != nil {
+		return domain.RollbackResult{}, fmt.Errorf("failed to lock game: %w", err)
+	}
+
+	rev, err := findRevision(ctx, tx, q.ID, q.Revision)
+	if err != nil {
+		return domain.RollbackResult{}, fmt.Errorf("failed to find revision: %w", err)
+	}
+
+	s := rev.Snapshot
+
+	if err := keepSlug(ctx, tx, q.ID, s.Slug); err != nil {
+		return domain.RollbackResult{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE public.games
+		SET
+			slug = $1,
+			mobile = $2,
+			status = $3,
+			url = $4,
+			width = $5,
+			height = $6,
+			weight = $7,
+			deleted_at = CASE WHEN $3 = 'deleted' THEN COALESCE(deleted_at, NOW()) END
+		WHERE id = $8
+	`, s.Slug, s.Mobile, s.Status.String(), s.URL, s.Width, s.Height, s.Weight, q.ID)
+	if err != nil {
+		return domain.RollbackResult{}, fmt.Errorf("failed to update: %w", err)
+	}
+
+	if err := replaceLinks(ctx, tx, q.ID, s.TagIDRefs, s.CategoryIDRefs); err != nil {
+		return domain.RollbackResult{}, err
+	}
+
+	for lang, t := range s.Texts {
+		res, err := tx.ExecContext(ctx, `
+			UPDATE public.game_texts
+			SET
+				name = $1,
+				short_description = $2,
+				description = $3,
+				content = $4,
+				player_1_controls = $5,
+				player_2_controls = $6
+			WHERE game_id = $7 AND language_id = $8
+		`, t.Name, t.ShortDescription, t.Description, t.Content, t.Player1Controls, t.Player2Controls, q.ID, langIDMap[lang])
+		if err != nil {
+			return domain.RollbackResult{}, fmt.Errorf("failed to update text for language %q: %w", lang, err)
+		}
+
+		if n, err := res.RowsAffected(); err != nil || n > 0 {
+			continue
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO public.game_texts (
+				game_id,
+				language_id,
+				name,
+				short_description,
+				description,
+				content,
+				player_1_controls,
+				player_2_controls
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, q.ID, langIDMap[lang], t.Name, t.ShortDescription, t.Description, t.Content, t.Player1Controls, t.Player2Controls)
+		if err != nil {
+			return domain.RollbackResult{}, fmt.Errorf("failed to insert text for language %q: %w", lang, err)
+		}
+	}
+
+	if err := insertRevision(ctx, tx, q.ID, domain.RevisionActionRollback, q.Author); err != nil {
+		return domain.RollbackResult{}, fmt.Errorf("failed to insert revision: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.RollbackResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	repoRes, err := r.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    q.ID,
+		Language: domain.LanguageEnglish,
+	})
+	if err != nil {
+		return domain.RollbackResult{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	return domain.RollbackResult(repoRes), nil
+}
+
+func replaceLinks(ctx context.Context, tx *sqlx.Tx, id int, tagIDs, categoryIDs []int) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM game_tags WHERE game_id = $1", id); err != nil {
+		return fmt.Errorf("failed to delete tags: %w", err)
+	}
+
+	for i, tagID := range tagIDs {
+		_, err := tx.ExecContext(ctx, "INSERT INTO game_tags (game_id, tag_id) VALUES ($1, $2)", id, tagID)
+		if err != nil {
+			return fmt.Errorf("failed to insert tag with ID %d at index %d: %w", tagID, i, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM game_categories WHERE game_id = $1", id); err != nil {
+		return fmt.Errorf("failed to delete categories: %w", err)
+	}
+
+	for i, categoryID := range categoryIDs {
+		_, err := tx.ExecContext(ctx, "INSERT INTO game_categories (game_id, category_id) VALUES ($1, $2)", id, categoryID)
+		if err != nil {
+			return fmt.Errorf("failed to insert category with ID %d at index %d: %w", categoryID, i, err)
+		}
+	}
+
+	return nil
+}
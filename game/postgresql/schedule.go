@@ -0,0 +1,336 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+type schedule struct {
+	GameID      int         `db:"game_id"`
+	PublishAt   pq.NullTime `db:"publish_at"`
+	UnpublishAt pq.NullTime `db:"unpublish_at"`
+	TotalCount  int         `db:"total_count"`
+}
+
+func (s schedule) toDomain() domain.Schedule {
+	return domain.Schedule{
+		GameID:      s.GameID,
+		PublishAt:   s.PublishAt.Time,
+		UnpublishAt: s.UnpublishAt.Time,
+	}
+}
+
+func nullTime(t time.Time) pq.NullTime {
+	return pq.NullTime{
+		Time:  t,
+		Valid: !t.IsZero(),
+	}
+}
+
+// lockGame locks the row of a game for the rest of the transaction and
+// returns its status.
+func lockGame(ctx context.Context, tx *sqlx.Tx, id int) (domain.Status, error) {
+	var status string
+
+	err := tx.GetContext(ctx, &status, `
+		SELECT status FROM public.games WHERE id = $1 FOR UPDATE
+	`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrNoData
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to lock game: %w", err)
+	}
+
+	return domain.Status(status), nil
+}
+
+// dropPendingSchedule drops what is left to do of the schedule of a game whose
+// status was set by hand. A published game keeps only its unpublish time, any
+// other game has nothing left for a schedule to do.
+func dropPendingSchedule(ctx context.Context, tx *sqlx.Tx, id int, status domain.Status) error {
+	if status == domain.StatusPublished {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE public.game_schedules SET publish_at = NULL WHERE game_id = $1
+		`, id)
+		if err != nil {
+			return fmt.Errorf("failed to clear publish time: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM public.game_schedules WHERE game_id = $1 AND unpublish_at IS NULL
+		`, id)
+		if err != nil {
+			return fmt.Errorf("failed to delete empty schedule: %w", err)
+		}
+
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM public.game_schedules WHERE game_id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (r repository) SaveSchedule(ctx context.Context, q domain.SaveScheduleQuery) (domain.SaveScheduleResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.SaveScheduleResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	id := q.Schedule.GameID
+
+	status, err := lockGame(ctx, tx, id)
+	if err != nil {
+		return domain.SaveScheduleResult{}, err
+	}
+
+	if status == domain.StatusDeleted {
+		return domain.SaveScheduleResult{}, fmt.Errorf("%w: game is deleted", domain.ErrInvalidStatus)
+	}
+
+	// A published game stays published, only its unpublish time is kept.
+	publishAt := q.Schedule.PublishAt
+	if status == domain.StatusPublished {
+		if q.Schedule.UnpublishAt.IsZero() {
+			return domain.SaveScheduleResult{}, fmt.Errorf("%w: game is already published", domain.ErrInvalidSchedule)
+		}
+
+		publishAt = time.Time{}
+	}
+
+	// Only published games are unpublished, any other game needs a publish
+	// time first.
+	if status != domain.StatusPublished && publishAt.IsZero() {
+		return domain.SaveScheduleResult{}, fmt.Errorf("%w: game is not published and has no publish time", domain.ErrInvalidSchedule)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO public.game_schedules (game_id, publish_at, unpublish_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (game_id) DO UPDATE
+		SET
+			publish_at = EXCLUDED.publish_at,
+			unpublish_at = EXCLUDED.unpublish_at
+	`, id, nullTime(publishAt), nullTime(q.Schedule.UnpublishAt))
+	if err != nil {
+		return domain.SaveScheduleResult{}, fmt.Errorf("failed to upsert: %w", err)
+	}
+
+	if !publishAt.IsZero() && status != domain.StatusScheduled {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE public.games SET status = 'scheduled' WHERE id = $1
+		`, id)
+		if err != nil {
+			return domain.SaveScheduleResult{}, fmt.Errorf("failed to update status: %w", err)
+		}
+
+		if err := insertRevision(ctx, tx, id, domain.RevisionActionEdit, q.Author); err != nil {
+			return domain.SaveScheduleResult{}, fmt.Errorf("failed to insert revision: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.SaveScheduleResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	repoRes, err := r.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    id,
+		Language: domain.LanguageEnglish,
+	})
+	if err != nil {
+		return domain.SaveScheduleResult{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	return domain.SaveScheduleResult(repoRes), nil
+}
+
+func (r repository) DeleteSchedule(ctx context.Context, q domain.DeleteScheduleQuery) (domain.DeleteScheduleResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.DeleteScheduleResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	status, err := lockGame(ctx, tx, q.ID)
+	if err != nil {
+		return domain.DeleteScheduleResult{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM public.game_schedules WHERE game_id = $1
+	`, q.ID)
+	if err != nil {
+		return domain.DeleteScheduleResult{}, fmt.Errorf("failed to delete: %w", err)
+	}
+
+	if status == domain.StatusScheduled {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE public.games SET status = 'invisible' WHERE id = $1
+		`, q.ID)
+		if err != nil {
+			return domain.DeleteScheduleResult{}, fmt.Errorf("failed to update status: %w", err)
+		}
+
+		if err := insertRevision(ctx, tx, q.ID, domain.RevisionActionEdit, q.Author); err != nil {
+			return domain.DeleteScheduleResult{}, fmt.Errorf("failed to insert revision: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.DeleteScheduleResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	repoRes, err := r.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    q.ID,
+		Language: domain.LanguageEnglish,
+	})
+	if err != nil {
+		return domain.DeleteScheduleResult{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	return domain.DeleteScheduleResult(repoRes), nil
+}
+
+func (r repository) FindSchedules(ctx context.Context, q domain.FindSchedulesQuery) (domain.FindSchedulesResult, error) {
+	var rows []schedule
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT
+			game_id,
+			publish_at,
+			unpublish_at,
+			COUNT(*) OVER() AS total_count
+		FROM public.game_schedules
+		ORDER BY LEAST(publish_at, unpublish_at), game_id
+		LIMIT $1 OFFSET $2
+	`, q.Limit, (q.Page-1)*q.Limit)
+	if err != nil {
+		return domain.FindSchedulesResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindSchedulesResult{
+		Data: make([]domain.Schedule, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Data = append(res.Data, row.toDomain())
+		res.Total = row.TotalCount
+	}
+
+	return res, nil
+}
+
+// ApplySchedules publishes before it unpublishes, so a game whose whole
+// window passed while the scheduler was down still gets its PublishedAt and
+// ends up invisible.
+// Status changes only apply to rows still in the expected status, which
+// keeps concurrent runs from applying a schedule twice.
+func (r repository) ApplySchedules(ctx context.Context, q domain.ApplySchedulesQuery) (domain.ApplySchedulesResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.ApplySchedulesResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	var res domain.ApplySchedulesResult
+
+	err = tx.SelectContext(ctx, &res.Published, `
+		UPDATE public.games g
+		SET
+			status = 'published',
+			published_at = NOW()
+		FROM public.game_schedules s
+		WHERE s.game_id = g.id
+			AND g.status = 'scheduled'
+			AND s.publish_at <= NOW()
+		RETURNING g.id
+	`)
+	if err != nil {
+		return domain.ApplySchedulesResult{}, fmt.Errorf("failed to publish: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM public.game_schedules WHERE game_id = ANY($1) AND unpublish_at IS NULL
+	`, pq.Array(res.Published))
+	if err != nil {
+		return domain.ApplySchedulesResult{}, fmt.Errorf("failed to delete applied schedules: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE public.game_schedules SET publish_at = NULL WHERE game_id = ANY($1)
+	`, pq.Array(res.Published))
+	if err != nil {
+		return domain.ApplySchedulesResult{}, fmt.Errorf("failed to clear publish times: %w", err)
+	}
+
+	err = tx.SelectContext(ctx, &res.Unpublished, `
+		UPDATE public.games g
+		SET status = 'invisible'
+		FROM public.game_schedules s
+		WHERE s.game_id = g.id
+			AND g.status = 'published'
+			AND s.unpublish_at <= NOW()
+		RETURNING g.id
+	`)
+	if err != nil {
+		return domain.ApplySchedulesResult{}, fmt.Errorf("failed to unpublish: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM public.game_schedules WHERE game_id = ANY($1)
+	`, pq.Array(res.Unpublished))
+	if err != nil {
+		return domain.ApplySchedulesResult{}, fmt.Errorf("failed to delete applied schedules: %w", err)
+	}
+
+	// An unpublish time that passed on a game that was not published, such
+	// as one deleted in the meantime, has nothing left to apply.
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM public.game_schedules s
+		USING public.games g
+		WHERE s.game_id = g.id
+			AND g.status != 'published'
+			AND s.publish_at IS NULL
+			AND s.unpublish_at <= NOW()
+	`)
+	if err != nil {
+		return domain.ApplySchedulesResult{}, fmt.Errorf("failed to delete stale schedules: %w", err)
+	}
+
+	changed := make(map[int]struct{}, len(res.Published)+len(res.Unpublished))
+
+	for _, id := range append(append([]int(nil), res.Published...), res.Unpublished...) {
+		if _, ok := changed[id]; ok {
+			continue
+		}
+
+		changed[id] = struct{}{}
+
+		if err := insertRevision(ctx, tx, id, domain.RevisionActionEdit, q.Author); err != nil {
+			return domain.ApplySchedulesResult{}, fmt.Errorf("failed to insert revision of game %d: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.ApplySchedulesResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+func (r repository) FindSlugRedirect(ctx context.Context, q domain.FindSlugRedirectQuery) (domain.FindSlugRedirectResult, error) {
+	var id int
+
+	err := r.db.GetContext(ctx, &id, `
+		SELECT game_id FROM public.game_slug_history WHERE slug = $1
+	`, q.Slug)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.FindSlugRedirectResult{}, domain.ErrNoData
+	}
+	if err != nil {
+		return domain.FindSlugRedirectResult{}, fmt.Errorf("failed to get: %w", err)
+	}
+
+	return domain.FindSlugRedirectResult{
+		ID: id,
+	}, nil
+}
+
+// keepSlug moves the current slug of the game to the history when it is about
+// to change to slug, and takes slug out of the history of any game. It has to
+// run before the new slug is written. A new game passes an id of 0.
+func keepSlug(ctx context.Context, tx *sqlx.Tx, id int, slug string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO public.game_slug_history (slug, game_id)
+		SELECT slug, id FROM public.games WHERE id = $1 AND slug != $2
+		ON CONFLICT (slug) DO UPDATE
+		SET
+			game_id = EXCLUDED.game_id,
+			replaced_at = NOW()
+	`, id, slug)
+	if err != nil {
+		return fmt.Errorf("failed to insert slug history: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM public.game_slug_history WHERE slug = $1
+	`, slug)
+	if err != nil {
+		return fmt.Errorf("failed to delete slug history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/events"
+	"github.com/vediagames/platform/game/domain"
+)
+
+type sink struct {
+	publisher events.Publisher
+	site      string
+}
+
+type Config struct {
+	Publisher events.Publisher
+	// Site tells the outboxes of the sites apart in the event IDs, as their
+	// row IDs overlap.
+	Site string
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Publisher == nil, fmt.Errorf("empty publisher"))
+	err.AddIf(c.Site == "", fmt.Errorf("empty site"))
+
+	return err.Err()
+}
+
+// NewSink forwards logged events as GAME_PLAYED, GAME_LIKED and
+// GAME_DISLIKED domain events.
+func NewSink(cfg Config) domain.EventSink {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &sink{
+		publisher: cfg.Publisher,
+		site:      cfg.Site,
+	}
+}
+
+func (s sink) Send(ctx context.Context, logged []domain.LoggedEvent) error {
+	evs := make([]events.Event, 0, len(logged))
+
+	for _, e := range logged {
+		ref := events.GameRef{ID: e.GameID}
+
+		var ev events.Event
+
+		switch e.Event {
+		case domain.EventPlay:
+			ev = events.GamePlayed(ref)
+		case domain.EventLike:
+			ev = events.GameLiked(ref)
+		case domain.EventDislike:
+			ev = events.GameDisliked(ref)
+		default:
+			return fmt.Errorf("unknown event: %s", e.Event)
+		}
+
+		// The ID follows the outbox row, so consumers can drop redeliveries.
+		ev.ID = uuid.NewSHA1(uuid.NameSpaceURL, []byte(s.site+"/game_events/"+strconv.FormatInt(e.ID, 10))).String()
+		ev.OccurredAt = e.LoggedAt.UTC().Format(time.RFC3339Nano)
+
+		evs = append(evs, ev)
+	}
+
+	if err := s.publisher.Publish(ctx, evs...); err != nil {
+		return fmt.Errorf("failed to publish: %w", err)
+	}
+
+	return nil
+}
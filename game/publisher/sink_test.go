@@ -0,0 +1,58 @@
+package publisher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vediagames/platform/events"
+	"github.com/vediagames/platform/game/domain"
+)
+
+type fakePublisher struct {
+	published []events.Event
+}
+
+func (p *fakePublisher) Publish(_ context.Context, evs ...events.Event) error {
+	p.published = append(p.published, evs...)
+	return nil
+}
+
+func TestSink_SendIDs(t *testing.T) {
+	logged := []domain.LoggedEvent{
+		{ID: 1, GameID: 10, Event: domain.EventPlay, LoggedAt: time.Now()},
+		{ID: 2, GameID: 10, Event: domain.EventLike, LoggedAt: time.Now()},
+	}
+
+	publisher := &fakePublisher{}
+	s := NewSink(Config{Publisher: publisher, Site: "vediagames"})
+	other := NewSink(Config{Publisher: publisher, Site: "mommagames"})
+
+	// The second send is a redelivery of the same rows.
+	for _, sk := range []domain.EventSink{s, s, other} {
+		if err := sk.Send(context.Background(), logged); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+
+	ids := make([]string, 0, len(publisher.published))
+	for _, ev := range publisher.published {
+		if err := ev.Validate(); err != nil {
+			t.Fatalf("invalid event: %v", err)
+		}
+
+		ids = append(ids, ev.ID)
+	}
+
+	if ids[0] != ids[2] || ids[1] != ids[3] {
+		t.Errorf("redelivered IDs = %v, want the IDs of the first send %v", ids[2:4], ids[0:2])
+	}
+
+	if ids[0] == ids[1] {
+		t.Errorf("rows 1 and 2 share ID %q", ids[0])
+	}
+
+	if ids[4] == ids[0] || ids[5] == ids[1] {
+		t.Errorf("IDs of other site = %v, want them to differ from %v", ids[4:6], ids[0:2])
+	}
+}
@@ -0,0 +1,193 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+type CounterConfig struct {
+	Client redis.UniversalClient
+	// Prefix separates the keys of sites sharing one Redis.
+	Prefix string
+	// LockTTL bounds how long a crashed instance keeps others from draining.
+	LockTTL time.Duration
+}
+
+func (c CounterConfig) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Client == nil, fmt.Errorf("empty client"))
+	err.AddIf(c.Prefix == "", fmt.Errorf("empty prefix"))
+	err.AddIf(c.LockTTL <= 0, fmt.Errorf("lock TTL must be positive"))
+
+	return err.Err()
+}
+
+// counterStore keeps deltas in a hash of "<game id>:<field>" to amount.
+// Drain moves the hash to a draining key under a lock, so only one instance
+// flushes at a time and a failed flush is retried from the draining key.
+type counterStore struct {
+	client      redis.UniversalClient
+	pendingKey  string
+	drainingKey string
+	lockKey     string
+	lockTTL     time.Duration
+	token       string
+}
+
+func NewCounterStore(cfg CounterConfig) domain.CounterStore {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &counterStore{
+		client:      cfg.Client,
+		pendingKey:  cfg.Prefix + ":pending",
+		drainingKey: cfg.Prefix + ":draining",
+		lockKey:     cfg.Prefix + ":lock",
+		lockTTL:     cfg.LockTTL,
+		token:       uuid.NewString(),
+	}
+}
+
+func field(id int, f domain.IncreasableField) string {
+	return fmt.Sprintf("%d:%s", id, f)
+}
+
+func (s counterStore) Add(ctx context.Context, q domain.IncreaseFieldQuery) error {
+	if err := s.client.HIncrBy(ctx, s.pendingKey, field(q.ID, q.Field), int64(q.ByAmount)).Err(); err != nil {
+		return fmt.Errorf("failed to increment: %w", err)
+	}
+
+	return nil
+}
+
+var drainScript = redis.NewScript(`
+if not redis.call('SET', KEYS[3], ARGV[1], 'NX', 'PX', ARGV[2]) then
+	if redis.call('GET', KEYS[3]) ~= ARGV[1] then
+		return false
+	end
+	redis.call('PEXPIRE', KEYS[3], ARGV[2])
+end
+if redis.call('EXISTS', KEYS[2]) == 0 and redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('RENAME', KEYS[1], KEYS[2])
+end
+local res = redis.call('HGETALL', KEYS[2])
+if #res == 0 then
+	redis.call('DEL', KEYS[3])
+end
+return res
+`)
+
+func (s counterStore) Drain(ctx context.Context) ([]domain.IncreaseFieldQuery, error) {
+	res, err := drainScript.Run(ctx, s.client,
+		[]string{s.pendingKey, s.drainingKey, s.lockKey},
+		s.token, s.lockTTL.Milliseconds(),
+	).StringSlice()
+	switch {
+	case errors.Is(err, redis.Nil):
+		// Another instance holds the lock.
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to run drain script: %w", err)
+	}
+
+	deltas := make([]domain.IncreaseFieldQuery, 0, len(res)/2)
+
+	for i := 0; i+1 < len(res); i += 2 {
+		idStr, f, ok := strings.Cut(res[i], ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid field %q", res[i])
+		}
+
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id in field %q: %w", res[i], err)
+		}
+
+		amount, err := strconv.Atoi(res[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount for field %q: %w", res[i], err)
+		}
+
+		deltas = append(deltas, domain.IncreaseFieldQuery{
+			ID:       id,
+			Field:    domain.IncreasableField(f),
+			ByAmount: amount,
+		})
+	}
+
+	return deltas, nil
+}
+
+var ackScript = redis.NewScript(`
+if redis.call('GET', KEYS[2]) == ARGV[1] then
+	redis.call('DEL', KEYS[1], KEYS[2])
+	return 1
+end
+return 0
+`)
+
+func (s counterStore) Ack(ctx context.Context) error {
+	acked, err := ackScript.Run(ctx, s.client, []string{s.drainingKey, s.lockKey}, s.token).Int()
+	if err != nil {
+		return fmt.Errorf("failed to run ack script: %w", err)
+	}
+
+	if acked == 0 {
+		return fmt.Errorf("lock expired before ack")
+	}
+
+	return nil
+}
+
+func (s counterStore) Pending(ctx context.Context, id int) (map[domain.IncreasableField]int, error) {
+	fields := []domain.IncreasableField{
+		domain.IncreaseFieldPlays,
+		domain.IncreaseFieldLikes,
+		domain.IncreaseFieldDislikes,
+	}
+
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, field(id, f))
+	}
+
+	pipe := s.client.Pipeline()
+	pending := pipe.HMGet(ctx, s.pendingKey, names...)
+	draining := pipe.HMGet(ctx, s.drainingKey, names...)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to exec: %w", err)
+	}
+
+	res := make(map[domain.IncreasableField]int, len(fields))
+
+	for _, cmd := range []*redis.SliceCmd{pending, draining} {
+		for i, v := range cmd.Val() {
+			str, ok := v.(string)
+			if !ok {
+				continue
+			}
+
+			amount, err := strconv.Atoi(str)
+			if err != nil {
+				return nil, fmt.Errorf("invalid amount for field %q: %w", names[i], err)
+			}
+
+			res[fields[i]] += amount
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/game/domain"
+)
+
+type SchedulerConfig struct {
+	Service domain.Service
+	// Interval is the time between two runs, a schedule is applied at most
+	// this late.
+	Interval time.Duration
+}
+
+func (c SchedulerConfig) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Service == nil, fmt.Errorf("empty service"))
+	err.AddIf(c.Interval <= 0, fmt.Errorf("interval must be positive"))
+
+	return err.Err()
+}
+
+// Scheduler publishes and unpublishes games as their schedules come due.
+type Scheduler struct {
+	service  domain.Service
+	interval time.Duration
+}
+
+func NewScheduler(cfg SchedulerConfig) *Scheduler {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &Scheduler{
+		service:  cfg.Service,
+		interval: cfg.Interval,
+	}
+}
+
+// Run applies the schedules every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			res, err := s.service.ApplySchedules(ctx)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to apply game schedules")
+				continue
+			}
+
+			if len(res.Published) == 0 && len(res.Unpublished) == 0 {
+				continue
+			}
+
+			zerolog.Ctx(ctx).Info().
+				Ints("published", res.Published).
+				Ints("unpublished", res.Unpublished).
+				Msg("applied game schedules")
+		}
+	}
+}
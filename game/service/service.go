@@ -2,17 +2,30 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/rs/zerolog"
 	"github.com/vediagames/zeroerror"
 
+	bucketdomain "github.com/vediagames/platform/bucket/domain"
+	"github.com/vediagames/platform/events"
 	"github.com/vediagames/platform/game/domain"
 )
 
 type Config struct {
 	Repository      domain.Repository
 	EventRepository domain.EventRepository
+	Publisher       events.Publisher
+	// BucketClient is only used by Purge to delete game assets, Purge fails
+	// without it.
+	BucketClient bucketdomain.Client
+	// EventLimit is the events allowed per EventWindow from one visitor and
+	// from one IP, 0 does not limit them. Repeated events of a visitor for
+	// the same game within EventWindow are only logged once.
+	EventLimit  int
+	EventWindow time.Duration
 }
 
 func (c Config) Validate() error {
@@ -20,6 +33,9 @@ func (c Config) Validate() error {
 
 	err.AddIf(c.Repository == nil, fmt.Errorf("empty repository"))
 	err.AddIf(c.EventRepository == nil, fmt.Errorf("empty event repository"))
+	err.AddIf(c.Publisher == nil, fmt.Errorf("empty publisher"))
+	err.AddIf(c.EventLimit < 0, fmt.Errorf("event limit must not be negative"))
+	err.AddIf(c.EventLimit > 0 && c.EventWindow <= 0, fmt.Errorf("event window must be positive"))
 
 	return err.Err()
 }
@@ -29,15 +45,31 @@ func New(config Config) domain.Service {
 		panic(fmt.Errorf("invalid config: %w", err))
 	}
 
-	return &service{
+	s := &service{
 		repository:      config.Repository,
 		eventRepository: config.EventRepository,
+		publisher:       config.Publisher,
+		bucketClient:    config.BucketClient,
 	}
+
+	if config.EventLimit > 0 {
+		s.visitorLimiter = newLimiter(config.EventLimit, config.EventWindow)
+		s.ipLimiter = newLimiter(config.EventLimit, config.EventWindow)
+		s.repeatLimiter = newLimiter(1, config.EventWindow)
+	}
+
+	return s
 }
 
 type service struct {
 	repository      domain.Repository
 	eventRepository domain.EventRepository
+	publisher       events.Publisher
+	bucketClient    bucketdomain.Client
+	// The limiters are nil when events are not limited.
+	visitorLimiter *limiter
+	ipLimiter      *limiter
+	repeatLimiter  *limiter
 }
 
 func (s service) Create(ctx context.Context, req domain.CreateRequest) (domain.CreateResponse, error) {
@@ -55,6 +87,8 @@ func (s service) Create(ctx context.Context, req domain.CreateRequest) (domain.C
 		return domain.CreateResponse{}, fmt.Errorf("invalid response: %w", err)
 	}
 
+	s.publish(ctx, events.GameCreated(eventGame(res.Data)))
+
 	return res, nil
 }
 
@@ -73,6 +107,8 @@ func (s service) Edit(ctx context.Context, req domain.EditRequest) (domain.EditR
 		return domain.EditResponse{}, fmt.Errorf("invalid response: %w", err)
 	}
 
+	s.publish(ctx, events.GameUpdated(eventGame(res.Data)))
+
 	return res, nil
 }
 
@@ -91,6 +127,273 @@ func (s service) Remove(ctx context.Context, req domain.RemoveRequest) (domain.R
 		return domain.RemoveResponse{}, fmt.Errorf("invalid response: %w", err)
 	}
 
+	s.publish(ctx, events.GameDeleted(events.GameRef{
+		ID:   req.ID,
+		Slug: req.Slug,
+	}))
+
+	return res, nil
+}
+
+func (s service) ListRevisions(ctx context.Context, req domain.ListRevisionsRequest) (domain.ListRevisionsResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.ListRevisionsResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	repoRes, err := s.repository.FindRevisions(ctx, domain.FindRevisionsQuery(req))
+	if err != nil {
+		return domain.ListRevisionsResponse{}, fmt.Errorf("failed to find revisions: %w", err)
+	}
+
+	res := domain.ListRevisionsResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.ListRevisionsResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) DiffRevisions(ctx context.Context, req domain.DiffRevisionsRequest) (domain.DiffRevisionsResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.DiffRevisionsResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	from, err := s.repository.FindRevision(ctx, domain.FindRevisionQuery{
+		ID:     req.ID,
+		Number: req.From,
+	})
+	if err != nil {
+		return domain.DiffRevisionsResponse{}, fmt.Errorf("failed to find revision %d: %w", req.From, err)
+	}
+
+	to, err := s.repository.FindRevision(ctx, domain.FindRevisionQuery{
+		ID:     req.ID,
+		Number: req.To,
+	})
+	if err != nil {
+		return domain.DiffRevisionsResponse{}, fmt.Errorf("failed to find revision %d: %w", req.To, err)
+	}
+
+	res := domain.DiffRevisionsResponse{
+		Data: from.Data.Snapshot.Diff(to.Data.Snapshot),
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.DiffRevisionsResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) Rollback(ctx context.Context, req domain.RollbackRequest) (domain.RollbackResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.RollbackResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	repoRes, err := s.repository.Rollback(ctx, domain.RollbackQuery(req))
+	if err != nil {
+		return domain.RollbackResponse{}, fmt.Errorf("failed to rollback: %w", err)
+	}
+
+	res := domain.RollbackResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.RollbackResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	if res.Data.Status == domain.StatusDeleted {
+		s.publish(ctx, events.GameDeleted(events.GameRef{
+			ID:   res.Data.ID,
+			Slug: res.Data.Slug,
+		}))
+	} else {
+		s.publish(ctx, events.GameUpdated(eventGame(res.Data)))
+	}
+
+	return res, nil
+}
+
+func (s service) Schedule(ctx context.Context, req domain.ScheduleRequest) (domain.ScheduleResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.ScheduleResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	schedule := domain.Schedule{
+		GameID:      req.ID,
+		PublishAt:   req.PublishAt,
+		UnpublishAt: req.UnpublishAt,
+	}
+
+	repoRes, err := s.repository.SaveSchedule(ctx, domain.SaveScheduleQuery{
+		Schedule: schedule,
+		Author:   req.Author,
+	})
+	if err != nil {
+		return domain.ScheduleResponse{}, fmt.Errorf("failed to save schedule: %w", err)
+	}
+
+	// The publish time of a game that is already live is not kept.
+	if repoRes.Data.Status == domain.StatusPublished {
+		schedule.PublishAt = time.Time{}
+	}
+
+	res := domain.ScheduleResponse{
+		Data:     repoRes.Data,
+		Schedule: schedule,
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.ScheduleResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	s.publish(ctx, events.GameUpdated(eventGame(res.Data)))
+
+	return res, nil
+}
+
+func (s service) Unschedule(ctx context.Context, req domain.UnscheduleRequest) (domain.UnscheduleResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.UnscheduleResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	repoRes, err := s.repository.DeleteSchedule(ctx, domain.DeleteScheduleQuery(req))
+	if err != nil {
+		return domain.UnscheduleResponse{}, fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	res := domain.UnscheduleResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.UnscheduleResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	s.publish(ctx, events.GameUpdated(eventGame(res.Data)))
+
+	return res, nil
+}
+
+func (s service) ListSchedules(ctx context.Context, req domain.ListSchedulesRequest) (domain.ListSchedulesResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.ListSchedulesResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	repoRes, err := s.repository.FindSchedules(ctx, domain.FindSchedulesQuery(req))
+	if err != nil {
+		return domain.ListSchedulesResponse{}, fmt.Errorf("failed to find schedules: %w", err)
+	}
+
+	res := domain.ListSchedulesResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.ListSchedulesResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) ApplySchedules(ctx context.Context) (domain.ApplySchedulesResponse, error) {
+	repoRes, err := s.repository.ApplySchedules(ctx, domain.ApplySchedulesQuery{
+		Author: domain.AuthorScheduler,
+	})
+	if err != nil {
+		return domain.ApplySchedulesResponse{}, fmt.Errorf("failed to apply schedules: %w", err)
+	}
+
+	changed := make(map[int]struct{}, len(repoRes.Published)+len(repoRes.Unpublished))
+	for _, id := range append(append([]int(nil), repoRes.Published...), repoRes.Unpublished...) {
+		changed[id] = struct{}{}
+	}
+
+	// The statuses are already changed, a game that cannot be read back only
+	// misses its event.
+	for id := range changed {
+		gameRes, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+			Field:    domain.GetByFieldID,
+			Value:    id,
+			Language: domain.LanguageEnglish,
+		})
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("game_id", id).Msg("failed to find scheduled game")
+			continue
+		}
+
+		s.publish(ctx, events.GameUpdated(eventGame(gameRes.Data)))
+	}
+
+	return domain.ApplySchedulesResponse(repoRes), nil
+}
+
+func (s service) Restore(ctx context.Context, req domain.RestoreRequest) (domain.RestoreResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.RestoreResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	repoRes, err := s.repository.Restore(ctx, domain.RestoreQuery(req))
+	if err != nil {
+		return domain.RestoreResponse{}, fmt.Errorf("failed to restore: %w", err)
+	}
+
+	res := domain.RestoreResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.RestoreResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	s.publish(ctx, events.GameUpdated(eventGame(res.Data)))
+
+	return res, nil
+}
+
+// Purge removes the games one by one, a game that fails is reported and the
+// rest are still purged. Assets are deleted after the rows are committed, a
+// dry run does not count them.
+func (s service) Purge(ctx context.Context, req domain.PurgeRequest) (domain.PurgeResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.PurgeResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	if s.bucketClient == nil {
+		return domain.PurgeResponse{}, fmt.Errorf("no bucket client to delete assets with")
+	}
+
+	deletedRes, err := s.repository.FindDeleted(ctx, domain.FindDeletedQuery{
+		IDs:           req.IDs,
+		DeletedBefore: req.DeletedBefore,
+	})
+	if err != nil {
+		return domain.PurgeResponse{}, fmt.Errorf("failed to find deleted: %w", err)
+	}
+
+	res := domain.PurgeResponse{
+		Data: make([]domain.PurgedGame, 0, len(deletedRes.Data)),
+	}
+
+	for _, game := range deletedRes.Data {
+		purged := domain.PurgedGame{
+			ID:        game.ID,
+			Slug:      game.Slug,
+			DeletedAt: game.DeletedAt,
+		}
+
+		purgeRes, err := s.repository.Purge(ctx, domain.PurgeQuery{
+			ID:     game.ID,
+			DryRun: req.DryRun,
+		})
+		if err != nil {
+			purged.Error = fmt.Sprintf("failed to purge: %s", err)
+			res.Data = append(res.Data, purged)
+
+			continue
+		}
+
+		purged.Rows = purgeRes.Rows
+
+		if !req.DryRun && game.Slug != "" {
+			purged.Assets, err = s.bucketClient.DeletePrefix(ctx, domain.AssetPrefix(game.Slug))
+			if err != nil {
+				purged.Error = fmt.Sprintf("failed to delete assets: %s", err)
+			}
+		}
+
+		res.Data = append(res.Data, purged)
+	}
+
 	return res, nil
 }
 
@@ -119,7 +422,9 @@ func (s service) List(ctx context.Context, req domain.ListRequest) (domain.ListR
 			return domain.ListResponse{}, fmt.Errorf("failed to search: %w", err)
 		}
 
-		res = domain.ListResponse(repoRes)
+		res = domain.ListResponse{
+			Data: repoRes.Data,
+		}
 	default:
 		repoRes, err := s.repository.Find(ctx, domain.FindQuery{
 			Language:       req.Language,
@@ -134,12 +439,18 @@ func (s service) List(ctx context.Context, req domain.ListRequest) (domain.ListR
 			ExcludedIDRefs: req.ExcludedIDRefs,
 			MobileOnly:     req.MobileOnly,
 			Slugs:          req.Slugs,
+			First:          req.First,
+			After:          req.After,
 		})
 		if err != nil {
 			return domain.ListResponse{}, fmt.Errorf("failed to find: %w", err)
 		}
 
-		res = domain.ListResponse(repoRes)
+		res = domain.ListResponse{
+			Data:        repoRes.Data,
+			Cursors:     repoRes.Cursors,
+			HasNextPage: repoRes.HasNextPage,
+		}
 	}
 
 	if err := res.Validate(); err != nil {
@@ -155,11 +466,50 @@ func (s service) Get(ctx context.Context, req domain.GetRequest) (domain.GetResp
 	}
 
 	repoRes, err := s.repository.FindOne(ctx, domain.FindOneQuery(req))
+	if errors.Is(err, domain.ErrNoData) && req.Field == domain.GetByFieldSlug {
+		return s.getRenamed(ctx, req)
+	}
 	if err != nil {
 		return domain.GetResponse{}, fmt.Errorf("failed to find one: %w", err)
 	}
 
-	res := domain.GetResponse(repoRes)
+	res := domain.GetResponse{
+		Data: repoRes.Data,
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.GetResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+// getRenamed gets a game by a slug it had before, ErrNoData is returned when
+// no game ever had the slug.
+func (s service) getRenamed(ctx context.Context, req domain.GetRequest) (domain.GetResponse, error) {
+	slug, _ := req.Value.(string)
+
+	redirectRes, err := s.repository.FindSlugRedirect(ctx, domain.FindSlugRedirectQuery{
+		Slug: slug,
+	})
+	if err != nil {
+		return domain.GetResponse{}, fmt.Errorf("failed to find slug redirect: %w", err)
+	}
+
+	repoRes, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    redirectRes.ID,
+		Language: req.Language,
+	})
+	if err != nil {
+		return domain.GetResponse{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	res := domain.GetResponse{
+		Data:       repoRes.Data,
+		RedirectTo: repoRes.Data.Slug,
+	}
+
 	if err := res.Validate(); err != nil {
 		return domain.GetResponse{}, fmt.Errorf("invalid response: %w", err)
 	}
@@ -192,7 +542,10 @@ func (s service) GetMostPlayedByDays(ctx context.Context, req domain.GetMostPlay
 		return domain.GetMostPlayedByDaysResponse{}, fmt.Errorf("failed to find: %w", err)
 	}
 
-	res := domain.GetMostPlayedByDaysResponse(listRes)
+	res := domain.GetMostPlayedByDaysResponse{
+		Data: listRes.Data,
+	}
+
 	if err := res.Validate(); err != nil {
 		return domain.GetMostPlayedByDaysResponse{}, fmt.Errorf("invalid response: %w", err)
 	}
@@ -218,7 +571,10 @@ func (s service) GetFresh(ctx context.Context, req domain.GetFreshRequest) (doma
 		return domain.GetFreshResponse{}, fmt.Errorf("failed to find: %w", err)
 	}
 
-	res := domain.GetFreshResponse(repoRes)
+	res := domain.GetFreshResponse{
+		Data: repoRes.Data,
+	}
+
 	if err := res.Validate(); err != nil {
 		return domain.GetFreshResponse{}, fmt.Errorf("invalid response: %w", err)
 	}
@@ -231,14 +587,203 @@ func (s service) LogEvent(ctx context.Context, req domain.LogEventRequest) error
 		return fmt.Errorf("invalid request: %w", ve)
 	}
 
-	err := s.eventRepository.Log(ctx, domain.LogQuery(req))
+	if s.visitorLimiter != nil {
+		// Every attempt counts, so a flood is cut off before anything else
+		// runs.
+		visitorAllowed := s.visitorLimiter.allow(req.Visitor.String())
+		ipAllowed := req.IP == "" || s.ipLimiter.allow(req.IP)
+
+		if !visitorAllowed || !ipAllowed {
+			return domain.ErrRateLimited
+		}
+
+		if !s.repeatLimiter.allow(fmt.Sprintf("%s|%s|%d", req.Visitor, req.Event, req.ID)) {
+			return nil
+		}
+	}
+
+	// Only published games are counted, which also keeps unknown IDs out of
+	// the event log.
+	findRes, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    req.ID,
+		Language: domain.LanguageEnglish,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find one: %w", err)
+	}
+
+	if findRes.Data.Status != domain.StatusPublished {
+		return fmt.Errorf("%w: game is not published", domain.ErrInvalidStatus)
+	}
+
+	err = s.eventRepository.Log(ctx, domain.LogQuery{
+		ID:    req.ID,
+		Event: req.Event,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to log: %w", err)
 	}
 
+	err = s.repository.IncreaseField(ctx, domain.IncreaseFieldQuery{
+		ID:       req.ID,
+		Field:    eventFields[req.Event],
+		ByAmount: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to increase field: %w", err)
+	}
+
+	// The play is already counted, a history that misses it is not worth
+	// failing the call for.
+	if req.Event == domain.EventPlay && req.Visitor != "" {
+		if err := s.repository.RecordPlay(ctx, domain.RecordPlayQuery{
+			ID:      req.ID,
+			Visitor: req.Visitor,
+		}); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("game_id", req.ID).Msg("failed to record play")
+		}
+	}
+
 	return nil
 }
 
+// GetRecentlyPlayed returns the play history of the visitor. Games that are
+// no longer visible are left out of the page, the total still counts them.
+func (s service) GetRecentlyPlayed(ctx context.Context, req domain.GetRecentlyPlayedRequest) (domain.GetRecentlyPlayedResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.GetRecentlyPlayedResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	historyRes, err := s.repository.FindPlayHistory(ctx, domain.FindPlayHistoryQuery{
+		Visitor: req.Visitor,
+		Page:    req.Page,
+		Limit:   req.Limit,
+	})
+	if err != nil {
+		return domain.GetRecentlyPlayedResponse{}, fmt.Errorf("failed to find play history: %w", err)
+	}
+
+	res := domain.GetRecentlyPlayedResponse{
+		Data:  make([]domain.PlayedGame, 0, len(historyRes.Data)),
+		Total: historyRes.Total,
+	}
+
+	if len(historyRes.Data) == 0 {
+		return res, nil
+	}
+
+	ids := make([]int, 0, len(historyRes.Data))
+	for _, play := range historyRes.Data {
+		ids = append(ids, play.ID)
+	}
+
+	findRes, err := s.repository.Find(ctx, domain.FindQuery{
+		Language: req.Language,
+		Page:     1,
+		Limit:    len(ids),
+		Sort:     domain.SortingMethodID,
+		IDRefs:   ids,
+	})
+	if err != nil {
+		return domain.GetRecentlyPlayedResponse{}, fmt.Errorf("failed to find: %w", err)
+	}
+
+	games := make(map[int]domain.Game, len(findRes.Data.Data))
+	for _, game := range findRes.Data.Data {
+		games[game.ID] = game
+	}
+
+	for _, play := range historyRes.Data {
+		game, ok := games[play.ID]
+		if !ok {
+			continue
+		}
+
+		res.Data = append(res.Data, domain.PlayedGame{
+			Game:         game,
+			Plays:        play.Plays,
+			LastPlayedAt: play.LastPlayedAt,
+		})
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.GetRecentlyPlayedResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+// React stores the reaction of the visitor. Reacting the same way twice
+// changes nothing, ReactionNone takes a reaction back. The counters move with
+// the stored reaction, so they only count the current reaction of each
+// visitor.
+func (s service) React(ctx context.Context, req domain.ReactRequest) (domain.ReactResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.ReactResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	repoRes, err := s.repository.React(ctx, domain.ReactQuery(req))
+	if err != nil {
+		return domain.ReactResponse{}, fmt.Errorf("failed to react: %w", err)
+	}
+
+	// The event log keeps recording likes and dislikes as they happen, the
+	// counters were already moved by React.
+	if event, ok := reactionEvents[req.Reaction]; ok && repoRes.Previous != req.Reaction {
+		if err := s.eventRepository.Log(ctx, domain.LogQuery{
+			ID:    req.ID,
+			Event: event,
+		}); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("game_id", req.ID).Msg("failed to log reaction event")
+		}
+	}
+
+	res := domain.ReactResponse{
+		Reaction: req.Reaction,
+		Likes:    repoRes.Likes,
+		Dislikes: repoRes.Dislikes,
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.ReactResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+var reactionEvents = map[domain.Reaction]domain.Event{
+	domain.ReactionLike:    domain.EventLike,
+	domain.ReactionDislike: domain.EventDislike,
+}
+
+func (s service) GetReactions(ctx context.Context, req domain.GetReactionsRequest) (domain.GetReactionsResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.GetReactionsResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	repoRes, err := s.repository.FindReactions(ctx, domain.FindReactionsQuery{
+		IDs:     req.IDs,
+		Visitor: req.Visitor,
+	})
+	if err != nil {
+		return domain.GetReactionsResponse{}, fmt.Errorf("failed to find reactions: %w", err)
+	}
+
+	res := domain.GetReactionsResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.GetReactionsResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+var eventFields = map[domain.Event]domain.IncreasableField{
+	domain.EventPlay:    domain.IncreaseFieldPlays,
+	domain.EventLike:    domain.IncreaseFieldLikes,
+	domain.EventDislike: domain.IncreaseFieldDislikes,
+}
+
 func (s service) Search(ctx context.Context, request domain.SearchRequest) (domain.SearchResponse, error) {
 	if err := request.Validate(); err != nil {
 		return domain.SearchResponse{}, fmt.Errorf("invalid request: %w", err)
@@ -274,3 +819,23 @@ func (s service) FullSearch(ctx context.Context, request domain.FullSearchReques
 
 	return res, nil
 }
+
+// publish never fails the calling write: the write has already been
+// committed, so a lost event is logged instead of surfaced to the caller.
+func (s service) publish(ctx context.Context, evs ...events.Event) {
+	if err := s.publisher.Publish(ctx, evs...); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to publish events")
+	}
+}
+
+func eventGame(g domain.Game) events.Game {
+	return events.Game{
+		ID:          g.ID,
+		Slug:        g.Slug,
+		Status:      string(g.Status),
+		Mobile:      g.Mobile,
+		URL:         g.URL,
+		TagIDs:      g.TagIDRefs,
+		CategoryIDs: g.CategoryIDRefs,
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eventsmemory "github.com/vediagames/platform/events/memory"
+	"github.com/vediagames/platform/game/domain"
+)
+
+type fakeRepository struct {
+	domain.Repository
+	games     map[int]domain.Status
+	increased int
+}
+
+func (r *fakeRepository) FindOne(_ context.Context, q domain.FindOneQuery) (domain.FindOneResult, error) {
+	status, ok := r.games[q.Value.(int)]
+	if !ok {
+		return domain.FindOneResult{}, domain.ErrNoData
+	}
+
+	return domain.FindOneResult{
+		Data: domain.Game{ID: q.Value.(int), Status: status},
+	}, nil
+}
+
+func (r *fakeRepository) IncreaseField(context.Context, domain.IncreaseFieldQuery) error {
+	r.increased++
+	return nil
+}
+
+func (r *fakeRepository) RecordPlay(context.Context, domain.RecordPlayQuery) error {
+	return nil
+}
+
+type fakeEventRepository struct {
+	logged []domain.LogQuery
+}
+
+func (r *fakeEventRepository) Log(_ context.Context, q domain.LogQuery) error {
+	r.logged = append(r.logged, q)
+	return nil
+}
+
+func TestService_LogEvent(t *testing.T) {
+	repo := &fakeRepository{
+		games: map[int]domain.Status{
+			1: domain.StatusPublished,
+			2: domain.StatusPublished,
+			3: domain.StatusPublished,
+			4: domain.StatusInvisible,
+		},
+	}
+	eventRepo := &fakeEventRepository{}
+
+	s := New(Config{
+		Repository:      repo,
+		EventRepository: eventRepo,
+		Publisher:       eventsmemory.New(),
+		EventLimit:      3,
+		EventWindow:     time.Hour,
+	})
+
+	alice := domain.VisitorFromSession("alice")
+	bob := domain.VisitorFromSession("bob")
+
+	tests := []struct {
+		name       string
+		req        domain.LogEventRequest
+		wantErr    error
+		wantLogged int
+	}{
+		{
+			name:       "played",
+			req:        domain.LogEventRequest{ID: 1, Event: domain.EventPlay, Visitor: alice, IP: "10.0.0.1"},
+			wantLogged: 1,
+		},
+		{
+			name:       "repeated play is logged once",
+			req:        domain.LogEventRequest{ID: 1, Event: domain.EventPlay, Visitor: alice, IP: "10.0.0.1"},
+			wantLogged: 1,
+		},
+		{
+			name:       "unknown game",
+			req:        domain.LogEventRequest{ID: 9, Event: domain.EventPlay, Visitor: bob, IP: "10.0.0.2"},
+			wantErr:    domain.ErrNoData,
+			wantLogged: 1,
+		},
+		{
+			name:       "invisible game",
+			req:        domain.LogEventRequest{ID: 4, Event: domain.EventPlay, Visitor: bob, IP: "10.0.0.2"},
+			wantErr:    domain.ErrInvalidStatus,
+			wantLogged: 1,
+		},
+		{
+			name:       "no visitor",
+			req:        domain.LogEventRequest{ID: 2, Event: domain.EventPlay, IP: "10.0.0.2"},
+			wantErr:    domain.ErrInvalidVisitor,
+			wantLogged: 1,
+		},
+		{
+			name:       "other game",
+			req:        domain.LogEventRequest{ID: 2, Event: domain.EventPlay, Visitor: alice, IP: "10.0.0.1"},
+			wantLogged: 2,
+		},
+		{
+			name:       "visitor limit",
+			req:        domain.LogEventRequest{ID: 3, Event: domain.EventPlay, Visitor: alice, IP: "10.0.0.3"},
+			wantErr:    domain.ErrRateLimited,
+			wantLogged: 2,
+		},
+		{
+			name:       "other visitor",
+			req:        domain.LogEventRequest{ID: 2, Event: domain.EventPlay, Visitor: bob, IP: "10.0.0.2"},
+			wantLogged: 3,
+		},
+		{
+			name:       "IP limit counted across visitors",
+			req:        domain.LogEventRequest{ID: 3, Event: domain.EventPlay, Visitor: domain.VisitorFromSession("carol"), IP: "10.0.0.2"},
+			wantErr:    domain.ErrRateLimited,
+			wantLogged: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.LogEvent(context.Background(), tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LogEvent() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(eventRepo.logged) != tt.wantLogged || repo.increased != tt.wantLogged {
+				t.Errorf("logged = %d, increased = %d, want %d", len(eventRepo.logged), repo.increased, tt.wantLogged)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/rs/zerolog"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	authdomain "github.com/vediagames/platform/auth/domain"
+	"github.com/vediagames/platform/gateway/graphql/model"
+)
+
+// Error codes set in the "code" extension of authorization errors.
+const (
+	errCodeUnauthenticated = "UNAUTHENTICATED"
+	errCodeForbidden       = "FORBIDDEN"
+)
+
+// hasRole implements the @hasRole directive. Callers without a user in the
+// context, or without the required role, are denied.
+func (r *Resolver) hasRole(ctx context.Context, _ interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+	field := graphql.GetFieldContext(ctx).Field.Name
+
+	user, err := r.authService.FromContext(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().
+			Err(err).
+			Str("field", field).
+			Str("required_role", role.String()).
+			Msg("denied unauthenticated call")
+
+		return nil, authError(ctx, errCodeUnauthenticated, "authentication required")
+	}
+
+	if !user.HasRole(role.Domain()) {
+		zerolog.Ctx(ctx).Warn().
+			Str("field", field).
+			Str("required_role", role.String()).
+			Str("user_id", user.ID).
+			Msg("denied unauthorized call")
+
+		return nil, authError(ctx, errCodeForbidden, "role "+role.String()+" required")
+	}
+
+	return next(ctx)
+}
+
+// author names the signed in user on the changes they make, such as game
+// revisions. It is empty for anonymous callers.
+func (r *Resolver) author(ctx context.Context) string {
+	user, err := r.authService.FromContext(ctx)
+	if err != nil {
+		return ""
+	}
+
+	if user.Username != "" {
+		return user.Username
+	}
+
+	return user.ID
+}
+
+func (r *Resolver) isEditor(ctx context.Context) bool {
+	user, err := r.authService.FromContext(ctx)
+
+	return err == nil && user.HasRole(authdomain.RoleEditor)
+}
+
+func authError(ctx context.Context, code, message string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Path:    graphql.GetPath(ctx),
+		Message: message,
+		Extensions: map[string]interface{}{
+			"code": code,
+		},
+	}
+}
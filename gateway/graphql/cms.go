@@ -1,62 +1,88 @@
 package graphql
 
-type listGame struct {
-	Slug        string
-	Label       string
-	Description string
-}
+import (
+	"context"
+	"fmt"
 
-func (l listGame) IsZero() bool {
-	return l.Slug == ""
-}
+	"github.com/rs/zerolog"
+
+	gamedomain "github.com/vediagames/platform/game/domain"
+	"github.com/vediagames/platform/gateway/graphql/model"
+	listdomain "github.com/vediagames/platform/list/domain"
+)
 
-type listGames []listGame
+// Slugs of the editorial lists backing the home page.
+const (
+	listSlugPromoted       = "promoted"
+	listSlugPickedByEditor = "picked-by-editor"
+	listSlugTrending       = "trending"
+	listSlugPopular        = "popular"
+)
 
-func (g listGames) Slugs() []string {
-	var slugs []string
-	for _, game := range g {
-		slugs = append(slugs, game.Slug)
+// listGames returns published games of the list in the order set by editors.
+// Games which are not found (deleted, invisible) are skipped.
+func (r *Resolver) listGames(ctx context.Context, language model.Language, slug string) ([]*model.ListGame, error) {
+	listRes, err := r.listService.Get(ctx, listdomain.GetRequest{
+		Slug: slug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list %q: %w", slug, err)
 	}
-	return slugs
-}
 
-func (g listGames) FindBySlug(slug string) listGame {
-	for _, game := range g {
-		if game.Slug == slug {
-			return game
-		}
+	if len(listRes.Data.Games) == 0 {
+		return []*model.ListGame{}, nil
 	}
 
-	return listGame{}
-}
+	gamesRes, err := r.gameService.List(ctx, gamedomain.ListRequest{
+		Language: gamedomain.Language(language),
+		Page:     1,
+		Limit:    len(listRes.Data.Games),
+		Sort:     gamedomain.SortingMethodMostPopular,
+		Slugs:    listRes.Data.Games.Slugs(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
 
-var promotedGame = listGame{
-	Slug:        "time-shooter-3-swat",
-	Label:       "SLOW MO 🕰️🔫",
-	Description: "Shoot the bad guys in slow motion.",
-}
+	gamesBySlug := make(map[string]gamedomain.Game, len(gamesRes.Data.Data))
+	for _, game := range gamesRes.Data.Data {
+		gamesBySlug[game.Slug] = game
+	}
 
-var pickedByEditor = listGame{
-	Slug:        "mx-offroad-master",
-	Label:       "SPORT",
-	Description: "",
-}
+	res := make([]*model.ListGame, 0, len(listRes.Data.Games))
 
-var trendingGames = listGames{
-	{Slug: "stick-man", Label: "", Description: ""},
-	{Slug: "basketball-legends", Label: "CLASSIC", Description: ""},
-	{Slug: "kirka-io", Label: "FPS", Description: ""},
-	{Slug: "god-simulator", Label: "PLAY GOD", Description: ""},
-	{Slug: "hole-io", Label: "", Description: ""},
-	{Slug: "minegame", Label: "", Description: ""},
-	{Slug: "smartphone-tycoon", Label: "TYCOON", Description: ""},
-	{Slug: "the-mergest-kingdom", Label: "MERGE", Description: ""},
-	{Slug: "beauty-run-run", Label: "", Description: ""},
+	for _, listGame := range listRes.Data.Games {
+		game, ok := gamesBySlug[listGame.Slug]
+		if !ok {
+			zerolog.Ctx(ctx).Warn().
+				Str("list", slug).
+				Str("slug", listGame.Slug).
+				Msg("list game not found")
+			continue
+		}
+
+		label, description := listGame.Label, listGame.Description
+
+		res = append(res, &model.ListGame{
+			Game:        model.Game{}.FromDomain(game),
+			Label:       &label,
+			Description: &description,
+		})
+	}
+
+	return res, nil
 }
 
-var popularGames = listGames{
-	{Slug: "skribbl-io", Label: "", Description: ""},
-	{Slug: "football-legends-2021", Label: "", Description: ""},
-	{Slug: "paperio-2", Label: "", Description: ""},
-	{Slug: "soccer-masters", Label: "", Description: ""},
+// firstListGame returns the top game of the list.
+func (r *Resolver) firstListGame(ctx context.Context, language model.Language, slug string) (*model.ListGame, error) {
+	games, err := r.listGames(ctx, language, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(games) == 0 {
+		return nil, fmt.Errorf("list %q has no games", slug)
+	}
+
+	return games[0], nil
 }
@@ -8,6 +8,13 @@ import (
 	"strconv"
 )
 
+type AddGameToListRequest struct {
+	ListSlug    string  `json:"listSlug"`
+	GameSlug    string  `json:"gameSlug"`
+	Label       *string `json:"label,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
 type AvailableLanguage struct {
 	Code Language `json:"code"`
 	Name string   `json:"name"`
@@ -22,6 +29,14 @@ type Categories struct {
 	Total int         `json:"total"`
 }
 
+type CategoriesConnectionRequest struct {
+	Language       Language `json:"language"`
+	First          int      `json:"first"`
+	After          *string  `json:"after,omitempty"`
+	AllowDeleted   bool     `json:"allowDeleted"`
+	AllowInvisible bool     `json:"allowInvisible"`
+}
+
 type CategoriesRequest struct {
 	Language       Language `json:"language"`
 	Page           int      `json:"page"`
@@ -49,6 +64,16 @@ type Category struct {
 	PublishedAt      *string  `json:"publishedAt,omitempty"`
 }
 
+type CategoryConnection struct {
+	Edges    []*CategoryEdge `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+type CategoryEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Category `json:"node"`
+}
+
 type CategoryRequest struct {
 	Field    GetByField `json:"field"`
 	Value    string     `json:"value"`
@@ -56,6 +81,39 @@ type CategoryRequest struct {
 }
 
 type CategoryResponse struct {
+	Category   *Category `json:"category"`
+	RedirectTo *string   `json:"redirectTo,omitempty"`
+}
+
+type ContactRejection struct {
+	ID        int    `json:"id"`
+	IP        string `json:"ip"`
+	From      string `json:"from"`
+	Name      string `json:"name"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+	Reason    string `json:"reason"`
+	Score     int    `json:"score"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type ContactRejectionsRequest struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+type ContactRejectionsResponse struct {
+	Data  []*ContactRejection `json:"data"`
+	Total int                 `json:"total"`
+}
+
+type CreateCategoryRequest struct {
+	Slug   string        `json:"slug"`
+	Status Status        `json:"status"`
+	Texts  []*TextsInput `json:"texts"`
+}
+
+type CreateCategoryResponse struct {
 	Category *Category `json:"category"`
 }
 
@@ -81,11 +139,55 @@ type CreateGameResponse struct {
 	Game *Game `json:"game"`
 }
 
+type CreateSectionRequest struct {
+	Slug       string        `json:"slug"`
+	Status     Status        `json:"status"`
+	Tags       []int         `json:"tags"`
+	Categories []int         `json:"categories"`
+	Games      []int         `json:"games"`
+	Texts      []*TextsInput `json:"texts"`
+}
+
+type CreateSectionResponse struct {
+	Section *Section `json:"section"`
+}
+
+type CreateTagRequest struct {
+	Slug   string        `json:"slug"`
+	Status Status        `json:"status"`
+	Texts  []*TextsInput `json:"texts"`
+}
+
+type CreateTagResponse struct {
+	Tag *Tag `json:"tag"`
+}
+
+type DeleteCategoryRequest struct {
+	Slug *string `json:"slug,omitempty"`
+	ID   *int    `json:"id,omitempty"`
+}
+
 type DeleteGameRequest struct {
 	Slug *string `json:"slug,omitempty"`
 	ID   *int    `json:"id,omitempty"`
 }
 
+type DeleteSectionRequest struct {
+	Slug *string `json:"slug,omitempty"`
+	ID   *int    `json:"id,omitempty"`
+}
+
+type DeleteTagRequest struct {
+	Slug *string `json:"slug,omitempty"`
+	ID   *int    `json:"id,omitempty"`
+}
+
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
 type FreshGamesRequest struct {
 	Language Language `json:"language"`
 	Page     int      `json:"page"`
@@ -107,6 +209,39 @@ type FullSearchRequest struct {
 	AllowInvisible bool           `json:"allowInvisible"`
 }
 
+type GameConnection struct {
+	Edges    []*GameEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type GameEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Game  `json:"node"`
+}
+
+type GameLiveness struct {
+	GameID              int     `json:"gameId"`
+	URL                 string  `json:"url"`
+	StatusCode          int     `json:"statusCode"`
+	LatencyMs           int     `json:"latencyMs"`
+	Error               string  `json:"error"`
+	CheckedAt           string  `json:"checkedAt"`
+	LastSuccessAt       *string `json:"lastSuccessAt,omitempty"`
+	ConsecutiveFailures int     `json:"consecutiveFailures"`
+	DisabledAt          *string `json:"disabledAt,omitempty"`
+}
+
+type GameLivenessRequest struct {
+	Page        int   `json:"page"`
+	Limit       int   `json:"limit"`
+	FailingOnly *bool `json:"failingOnly,omitempty"`
+}
+
+type GameLivenessResponse struct {
+	Data  []*GameLiveness `json:"data"`
+	Total int             `json:"total"`
+}
+
 type GameRequest struct {
 	Field    GetByField `json:"field"`
 	Value    string     `json:"value"`
@@ -114,7 +249,44 @@ type GameRequest struct {
 }
 
 type GameResponse struct {
-	Game *Game `json:"game"`
+	Game       *Game   `json:"game"`
+	RedirectTo *string `json:"redirectTo,omitempty"`
+}
+
+type GameRevision struct {
+	GameID        int      `json:"gameId"`
+	Number        int      `json:"number"`
+	Action        string   `json:"action"`
+	Author        string   `json:"author"`
+	CreatedAt     string   `json:"createdAt"`
+	ChangedFields []string `json:"changedFields"`
+}
+
+type GameRevisionsRequest struct {
+	GameID int `json:"gameId"`
+	Page   int `json:"page"`
+	Limit  int `json:"limit"`
+}
+
+type GameRevisionsResponse struct {
+	Data  []*GameRevision `json:"data"`
+	Total int             `json:"total"`
+}
+
+type GameSchedule struct {
+	GameID      int     `json:"gameId"`
+	PublishAt   *string `json:"publishAt,omitempty"`
+	UnpublishAt *string `json:"unpublishAt,omitempty"`
+}
+
+type GameSchedulesRequest struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+type GameSchedulesResponse struct {
+	Data  []*GameSchedule `json:"data"`
+	Total int             `json:"total"`
 }
 
 type Games struct {
@@ -122,6 +294,20 @@ type Games struct {
 	Total int     `json:"total"`
 }
 
+type GamesConnectionRequest struct {
+	Language        Language       `json:"language"`
+	First           int            `json:"first"`
+	After           *string        `json:"after,omitempty"`
+	AllowDeleted    bool           `json:"allowDeleted"`
+	AllowInvisible  bool           `json:"allowInvisible"`
+	Sort            *SortingMethod `json:"sort,omitempty"`
+	Categories      []int          `json:"categories,omitempty"`
+	Tags            []int          `json:"tags,omitempty"`
+	Ids             []int          `json:"ids,omitempty"`
+	ExcludedGameIDs []int          `json:"excludedGameIDs,omitempty"`
+	Slugs           []string       `json:"slugs,omitempty"`
+}
+
 type GamesRequest struct {
 	Language        Language       `json:"language"`
 	Page            int            `json:"page"`
@@ -141,12 +327,66 @@ type GamesResponse struct {
 	Games *Games `json:"games"`
 }
 
+type ImportGamesRequest struct {
+	Provider string `json:"provider"`
+	Page     *int   `json:"page,omitempty"`
+	Limit    int    `json:"limit"`
+}
+
+type ImportGamesResponse struct {
+	Imported []*ImportedGame `json:"imported"`
+	Skipped  []*SkippedGame  `json:"skipped"`
+}
+
+type ImportedGame struct {
+	ID     int    `json:"id"`
+	Slug   string `json:"slug"`
+	Images int    `json:"images"`
+}
+
+type List struct {
+	Slug        string      `json:"slug"`
+	Name        string      `json:"name"`
+	Description *string     `json:"description,omitempty"`
+	Games       []*ListItem `json:"games"`
+}
+
 type ListGame struct {
 	Game        *Game   `json:"game"`
 	Label       *string `json:"label,omitempty"`
 	Description *string `json:"description,omitempty"`
 }
 
+type ListItem struct {
+	Slug        string  `json:"slug"`
+	Label       *string `json:"label,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Position    int     `json:"position"`
+	InsertedAt  string  `json:"insertedAt"`
+}
+
+type ListRequest struct {
+	Slug string `json:"slug"`
+}
+
+type ListResponse struct {
+	List *List `json:"list"`
+}
+
+type Lists struct {
+	Data  []*List `json:"data"`
+	Total int     `json:"total"`
+}
+
+type ListsRequest struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+type ListsResponse struct {
+	Lists *Lists `json:"lists"`
+}
+
 type MostPlayedGamesRequest struct {
 	Language Language `json:"language"`
 	Page     int      `json:"page"`
@@ -158,6 +398,11 @@ type MostPlayedGamesResponse struct {
 	Games *Games `json:"games"`
 }
 
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor,omitempty"`
+}
+
 type PlacedSection struct {
 	Section   *Section `json:"section"`
 	Placement int      `json:"placement"`
@@ -175,6 +420,17 @@ type PlacedSectionsResponse struct {
 	PlacedSections *PlacedSections `json:"placedSections"`
 }
 
+type PlacementInput struct {
+	Placement int `json:"placement"`
+	SectionID int `json:"sectionId"`
+}
+
+type PlayedGame struct {
+	Game         *Game  `json:"game"`
+	Plays        int    `json:"plays"`
+	LastPlayedAt string `json:"lastPlayedAt"`
+}
+
 type PromotedTag struct {
 	ID        int    `json:"id"`
 	Slug      string `json:"slug"`
@@ -183,6 +439,38 @@ type PromotedTag struct {
 	Thumbnail string `json:"thumbnail"`
 }
 
+type ProviderGame struct {
+	Name        string   `json:"name"`
+	URL         string   `json:"url"`
+	Description string   `json:"description"`
+	Controls    string   `json:"controls"`
+	Mobile      bool     `json:"mobile"`
+	Height      int      `json:"height"`
+	Width       int      `json:"width"`
+	Categories  []string `json:"categories"`
+	Tags        []string `json:"tags"`
+	Images      []string `json:"images"`
+	Slug        string   `json:"slug"`
+	Provider    string   `json:"provider"`
+	ProviderID  string   `json:"providerId"`
+}
+
+type ProviderGamesRequest struct {
+	Provider   string  `json:"provider"`
+	Page       int     `json:"page"`
+	Limit      int     `json:"limit"`
+	Category   *string `json:"category,omitempty"`
+	MobileOnly *bool   `json:"mobileOnly,omitempty"`
+	Query      *string `json:"query,omitempty"`
+}
+
+type ProviderGamesResponse struct {
+	Data         []*ProviderGame `json:"data"`
+	Total        int             `json:"total"`
+	PageFiltered bool            `json:"pageFiltered"`
+	HasNextPage  bool            `json:"hasNextPage"`
+}
+
 type Quote struct {
 	Message   string `json:"message"`
 	Author    string `json:"author"`
@@ -201,6 +489,51 @@ type RandomProviderGameResponse struct {
 	Tags        []string `json:"tags"`
 	Images      []string `json:"images"`
 	Slug        string   `json:"slug"`
+	Provider    string   `json:"provider"`
+	ProviderID  string   `json:"providerId"`
+}
+
+type ReactResponse struct {
+	Reaction GameReaction `json:"reaction"`
+	Likes    int          `json:"likes"`
+	Dislikes int          `json:"dislikes"`
+}
+
+type RecentlyPlayedGamesRequest struct {
+	Language Language `json:"language"`
+	Page     int      `json:"page"`
+	Limit    int      `json:"limit"`
+}
+
+type RecentlyPlayedGamesResponse struct {
+	Games []*PlayedGame `json:"games"`
+	Total int           `json:"total"`
+}
+
+type RemoveGameFromListRequest struct {
+	ListSlug string `json:"listSlug"`
+	GameSlug string `json:"gameSlug"`
+}
+
+type ReorderListRequest struct {
+	ListSlug  string   `json:"listSlug"`
+	GameSlugs []string `json:"gameSlugs"`
+}
+
+type RestoreGameRequest struct {
+	Slug *string `json:"slug,omitempty"`
+	ID   *int    `json:"id,omitempty"`
+}
+
+type ScheduleGameRequest struct {
+	ID          int     `json:"id"`
+	PublishAt   *string `json:"publishAt,omitempty"`
+	UnpublishAt *string `json:"unpublishAt,omitempty"`
+}
+
+type ScheduleGameResponse struct {
+	Game     *Game         `json:"game"`
+	Schedule *GameSchedule `json:"schedule"`
 }
 
 type SearchItem struct {
@@ -260,10 +593,17 @@ type SectionsResponse struct {
 }
 
 type SendEmailRequest struct {
-	From    string `json:"from"`
-	Name    string `json:"name"`
-	Subject string `json:"subject"`
-	Body    string `json:"body"`
+	From     string  `json:"from"`
+	Name     string  `json:"name"`
+	Subject  string  `json:"subject"`
+	Body     string  `json:"body"`
+	Honeypot *string `json:"honeypot,omitempty"`
+	Proof    *string `json:"proof,omitempty"`
+}
+
+type SkippedGame struct {
+	Slug   string `json:"slug"`
+	Reason string `json:"reason"`
 }
 
 type Tag struct {
@@ -282,6 +622,16 @@ type Tag struct {
 	Thumbnail        string   `json:"thumbnail"`
 }
 
+type TagConnection struct {
+	Edges    []*TagEdge `json:"edges"`
+	PageInfo *PageInfo  `json:"pageInfo"`
+}
+
+type TagEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Tag   `json:"node"`
+}
+
 type TagRequest struct {
 	Field    GetByField `json:"field"`
 	Value    string     `json:"value"`
@@ -289,7 +639,8 @@ type TagRequest struct {
 }
 
 type TagResponse struct {
-	Tag *Tag `json:"tag"`
+	Tag        *Tag    `json:"tag"`
+	RedirectTo *string `json:"redirectTo,omitempty"`
 }
 
 type TagSection struct {
@@ -307,6 +658,15 @@ type Tags struct {
 	Total int    `json:"total"`
 }
 
+type TagsConnectionRequest struct {
+	Language       Language       `json:"language"`
+	First          int            `json:"first"`
+	After          *string        `json:"after,omitempty"`
+	AllowDeleted   bool           `json:"allowDeleted"`
+	AllowInvisible bool           `json:"allowInvisible"`
+	Sort           *SortingMethod `json:"sort,omitempty"`
+}
+
 type TagsRequest struct {
 	Language       Language       `json:"language"`
 	Page           int            `json:"page"`
@@ -320,6 +680,14 @@ type TagsResponse struct {
 	Tags *Tags `json:"tags"`
 }
 
+type TextsInput struct {
+	Language         Language `json:"language"`
+	Name             string   `json:"name"`
+	ShortDescription *string  `json:"shortDescription,omitempty"`
+	Description      *string  `json:"description,omitempty"`
+	Content          *string  `json:"content,omitempty"`
+}
+
 type ThumbnailRequest struct {
 	Original OriginalThumbnail `json:"original"`
 	Width    *int              `json:"width,omitempty"`
@@ -335,6 +703,17 @@ type TopTag struct {
 	Category  string `json:"category"`
 }
 
+type UpdateCategoryRequest struct {
+	ID     int           `json:"id"`
+	Slug   string        `json:"slug"`
+	Status Status        `json:"status"`
+	Texts  []*TextsInput `json:"texts"`
+}
+
+type UpdateCategoryResponse struct {
+	Category *Category `json:"category"`
+}
+
 type UpdateGameRequest struct {
 	ID               int     `json:"id"`
 	Slug             string  `json:"slug"`
@@ -345,9 +724,6 @@ type UpdateGameRequest struct {
 	URL              string  `json:"url"`
 	Width            int     `json:"width"`
 	Height           int     `json:"height"`
-	Likes            int     `json:"likes"`
-	Dislikes         int     `json:"dislikes"`
-	Plays            int     `json:"plays"`
 	Weight           int     `json:"weight"`
 	Name             string  `json:"name"`
 	ShortDescription string  `json:"shortDescription"`
@@ -355,12 +731,40 @@ type UpdateGameRequest struct {
 	Player1Controls  string  `json:"player1Controls"`
 	Content          *string `json:"content,omitempty"`
 	Player2Controls  *string `json:"player2Controls,omitempty"`
+	Likes            *int    `json:"likes,omitempty"`
+	Dislikes         *int    `json:"dislikes,omitempty"`
+	Plays            *int    `json:"plays,omitempty"`
 }
 
 type UpdateGameResponse struct {
 	Game *Game `json:"game"`
 }
 
+type UpdateSectionRequest struct {
+	ID         int           `json:"id"`
+	Slug       string        `json:"slug"`
+	Status     Status        `json:"status"`
+	Tags       []int         `json:"tags"`
+	Categories []int         `json:"categories"`
+	Games      []int         `json:"games"`
+	Texts      []*TextsInput `json:"texts"`
+}
+
+type UpdateSectionResponse struct {
+	Section *Section `json:"section"`
+}
+
+type UpdateTagRequest struct {
+	ID     int           `json:"id"`
+	Slug   string        `json:"slug"`
+	Status Status        `json:"status"`
+	Texts  []*TextsInput `json:"texts"`
+}
+
+type UpdateTagResponse struct {
+	Tag *Tag `json:"tag"`
+}
+
 type GameReaction string
 
 const (
@@ -617,6 +1021,49 @@ func (e OriginalVideo) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type Role string
+
+const (
+	RoleAdmin  Role = "ADMIN"
+	RoleEditor Role = "EDITOR"
+	RoleViewer Role = "VIEWER"
+)
+
+var AllRole = []Role{
+	RoleAdmin,
+	RoleEditor,
+	RoleViewer,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleAdmin, RoleEditor, RoleViewer:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SearchItemType string
 
 const (
@@ -673,6 +1120,7 @@ const (
 	SortingMethodMostDisliked  SortingMethod = "most_disliked"
 	SortingMethodLeastDisliked SortingMethod = "least_disliked"
 	SortingMethodMostRelevant  SortingMethod = "most_relevant"
+	SortingMethodTrending      SortingMethod = "trending"
 )
 
 var AllSortingMethod = []SortingMethod{
@@ -688,11 +1136,12 @@ var AllSortingMethod = []SortingMethod{
 	SortingMethodMostDisliked,
 	SortingMethodLeastDisliked,
 	SortingMethodMostRelevant,
+	SortingMethodTrending,
 }
 
 func (e SortingMethod) IsValid() bool {
 	switch e {
-	case SortingMethodID, SortingMethodName, SortingMethodRandom, SortingMethodMostPopular, SortingMethodLeastPopular, SortingMethodNewest, SortingMethodOldest, SortingMethodMostLiked, SortingMethodLeastLiked, SortingMethodMostDisliked, SortingMethodLeastDisliked, SortingMethodMostRelevant:
+	case SortingMethodID, SortingMethodName, SortingMethodRandom, SortingMethodMostPopular, SortingMethodLeastPopular, SortingMethodNewest, SortingMethodOldest, SortingMethodMostLiked, SortingMethodLeastLiked, SortingMethodMostDisliked, SortingMethodLeastDisliked, SortingMethodMostRelevant, SortingMethodTrending:
 		return true
 	}
 	return false
@@ -725,17 +1174,19 @@ const (
 	StatusInvisible Status = "invisible"
 	StatusPublished Status = "published"
 	StatusDeleted   Status = "deleted"
+	StatusScheduled Status = "scheduled"
 )
 
 var AllStatus = []Status{
 	StatusInvisible,
 	StatusPublished,
 	StatusDeleted,
+	StatusScheduled,
 }
 
 func (e Status) IsValid() bool {
 	switch e {
-	case StatusInvisible, StatusPublished, StatusDeleted:
+	case StatusInvisible, StatusPublished, StatusDeleted, StatusScheduled:
 		return true
 	}
 	return false
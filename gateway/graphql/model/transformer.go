@@ -1,16 +1,26 @@
 package model
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
+	authdomain "github.com/vediagames/platform/auth/domain"
 	categorydomain "github.com/vediagames/platform/category/domain"
+	contactdomain "github.com/vediagames/platform/contact/domain"
+	fetcherdomain "github.com/vediagames/platform/fetcher/domain"
 	gamedomain "github.com/vediagames/platform/game/domain"
 	imagedomain "github.com/vediagames/platform/image/domain"
+	importerdomain "github.com/vediagames/platform/importer/domain"
+	listdomain "github.com/vediagames/platform/list/domain"
+	livenessdomain "github.com/vediagames/platform/liveness/domain"
 	searchdomain "github.com/vediagames/platform/search/domain"
 	sectiondomain "github.com/vediagames/platform/section/domain"
 	tagdomain "github.com/vediagames/platform/tag/domain"
 )
 
+// Domain ignores the deprecated Likes, Dislikes and Plays, which older clients
+// still send.
 func (r UpdateGameRequest) Domain() gamedomain.EditRequest {
 	return gamedomain.EditRequest{
 		ID:             r.ID,
@@ -20,9 +30,6 @@ func (r UpdateGameRequest) Domain() gamedomain.EditRequest {
 		CategoryIDRefs: gamedomain.IDs(r.Categories),
 		Status:         gamedomain.Status(r.Status),
 		URL:            r.URL,
-		Likes:          r.Likes,
-		Dislikes:       r.Dislikes,
-		Plays:          r.Plays,
 		Width:          r.Width,
 		Height:         r.Height,
 		Weight:         r.Weight,
@@ -63,6 +70,173 @@ func (r CreateGameRequest) Domain() gamedomain.CreateRequest {
 	}
 }
 
+func (r CreateSectionRequest) Domain() sectiondomain.CreateRequest {
+	return sectiondomain.CreateRequest{
+		Slug:           r.Slug,
+		Status:         sectiondomain.Status(r.Status),
+		TagIDRefs:      sectiondomain.IDs(r.Tags),
+		CategoryIDRefs: sectiondomain.IDs(r.Categories),
+		GameIDRefs:     sectiondomain.IDs(r.Games),
+		Texts:          sectionTextsToDomain(r.Texts),
+	}
+}
+
+func (r UpdateSectionRequest) Domain() sectiondomain.EditRequest {
+	return sectiondomain.EditRequest{
+		ID:             r.ID,
+		Slug:           r.Slug,
+		Status:         sectiondomain.Status(r.Status),
+		TagIDRefs:      sectiondomain.IDs(r.Tags),
+		CategoryIDRefs: sectiondomain.IDs(r.Categories),
+		GameIDRefs:     sectiondomain.IDs(r.Games),
+		Texts:          sectionTextsToDomain(r.Texts),
+	}
+}
+
+func (r DeleteSectionRequest) Domain() sectiondomain.RemoveRequest {
+	var req sectiondomain.RemoveRequest
+
+	if r.ID != nil {
+		req.ID = *r.ID
+	}
+
+	if r.Slug != nil {
+		req.Slug = *r.Slug
+	}
+
+	return req
+}
+
+func sectionTextsToDomain(texts []*TextsInput) map[sectiondomain.Language]sectiondomain.Texts {
+	res := make(map[sectiondomain.Language]sectiondomain.Texts, len(texts))
+
+	for _, t := range texts {
+		res[sectiondomain.Language(t.Language)] = sectiondomain.Texts{
+			Name:             t.Name,
+			ShortDescription: pointerToString(t.ShortDescription),
+			Description:      pointerToString(t.Description),
+			Content:          pointerToString(t.Content),
+		}
+	}
+
+	return res
+}
+
+func (r CreateTagRequest) Domain() tagdomain.CreateRequest {
+	return tagdomain.CreateRequest{
+		Slug:   r.Slug,
+		Status: tagdomain.Status(r.Status),
+		Texts:  tagTextsToDomain(r.Texts),
+	}
+}
+
+func (r UpdateTagRequest) Domain() tagdomain.EditRequest {
+	return tagdomain.EditRequest{
+		ID:     r.ID,
+		Slug:   r.Slug,
+		Status: tagdomain.Status(r.Status),
+		Texts:  tagTextsToDomain(r.Texts),
+	}
+}
+
+func (r DeleteTagRequest) Domain() tagdomain.RemoveRequest {
+	var req tagdomain.RemoveRequest
+
+	if r.ID != nil {
+		req.ID = *r.ID
+	}
+
+	if r.Slug != nil {
+		req.Slug = *r.Slug
+	}
+
+	return req
+}
+
+func tagTextsToDomain(texts []*TextsInput) map[tagdomain.Language]tagdomain.Texts {
+	res := make(map[tagdomain.Language]tagdomain.Texts, len(texts))
+
+	for _, t := range texts {
+		res[tagdomain.Language(t.Language)] = tagdomain.Texts{
+			Name:             t.Name,
+			ShortDescription: pointerToString(t.ShortDescription),
+			Description:      pointerToString(t.Description),
+			Content:          pointerToString(t.Content),
+		}
+	}
+
+	return res
+}
+
+func (r CreateCategoryRequest) Domain() categorydomain.CreateRequest {
+	return categorydomain.CreateRequest{
+		Slug:   r.Slug,
+		Status: categorydomain.Status(r.Status),
+		Texts:  categoryTextsToDomain(r.Texts),
+	}
+}
+
+func (r UpdateCategoryRequest) Domain() categorydomain.EditRequest {
+	return categorydomain.EditRequest{
+		ID:     r.ID,
+		Slug:   r.Slug,
+		Status: categorydomain.Status(r.Status),
+		Texts:  categoryTextsToDomain(r.Texts),
+	}
+}
+
+func (r DeleteCategoryRequest) Domain() categorydomain.RemoveRequest {
+	var req categorydomain.RemoveRequest
+
+	if r.ID != nil {
+		req.ID = *r.ID
+	}
+
+	if r.Slug != nil {
+		req.Slug = *r.Slug
+	}
+
+	return req
+}
+
+func categoryTextsToDomain(texts []*TextsInput) map[categorydomain.Language]categorydomain.Texts {
+	res := make(map[categorydomain.Language]categorydomain.Texts, len(texts))
+
+	for _, t := range texts {
+		res[categorydomain.Language(t.Language)] = categorydomain.Texts{
+			Name:             t.Name,
+			ShortDescription: pointerToString(t.ShortDescription),
+			Description:      pointerToString(t.Description),
+			Content:          pointerToString(t.Content),
+		}
+	}
+
+	return res
+}
+
+func (r AddGameToListRequest) Domain() listdomain.AddGameRequest {
+	return listdomain.AddGameRequest{
+		ListSlug:    r.ListSlug,
+		GameSlug:    r.GameSlug,
+		Label:       pointerToString(r.Label),
+		Description: pointerToString(r.Description),
+	}
+}
+
+func (r RemoveGameFromListRequest) Domain() listdomain.RemoveGameRequest {
+	return listdomain.RemoveGameRequest{
+		ListSlug: r.ListSlug,
+		GameSlug: r.GameSlug,
+	}
+}
+
+func (r ReorderListRequest) Domain() listdomain.ReorderRequest {
+	return listdomain.ReorderRequest{
+		ListSlug:  r.ListSlug,
+		GameSlugs: r.GameSlugs,
+	}
+}
+
 func (g Games) IDs() []int {
 	ids := make([]int, 0, len(g.Data))
 	for _, e := range g.Data {
@@ -84,6 +258,28 @@ func (g Games) FromDomain(domain gamedomain.Games) *Games {
 	return games
 }
 
+func (c GameConnection) FromDomain(domain gamedomain.ListResponse) *GameConnection {
+	conn := &GameConnection{
+		Edges: make([]*GameEdge, 0, len(domain.Data.Data)),
+		PageInfo: &PageInfo{
+			HasNextPage: domain.HasNextPage,
+		},
+	}
+
+	for i, domainGame := range domain.Data.Data {
+		conn.Edges = append(conn.Edges, &GameEdge{
+			Cursor: domain.Cursors[i].String(),
+			Node:   Game{}.FromDomain(domainGame),
+		})
+	}
+
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.EndCursor = stringToPointer(conn.Edges[len(conn.Edges)-1].Cursor)
+	}
+
+	return conn
+}
+
 func (g Game) FromDomain(domain gamedomain.Game) *Game {
 	return &Game{
 		ID:               domain.ID,
@@ -133,6 +329,28 @@ func (t Tags) FromDomain(domain tagdomain.Tags) *Tags {
 	return tags
 }
 
+func (c TagConnection) FromDomain(domain tagdomain.ListResponse) *TagConnection {
+	conn := &TagConnection{
+		Edges: make([]*TagEdge, 0, len(domain.Data.Data)),
+		PageInfo: &PageInfo{
+			HasNextPage: domain.HasNextPage,
+		},
+	}
+
+	for i, domainTag := range domain.Data.Data {
+		conn.Edges = append(conn.Edges, &TagEdge{
+			Cursor: domain.Cursors[i].String(),
+			Node:   Tag{}.FromDomain(domainTag),
+		})
+	}
+
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.EndCursor = stringToPointer(conn.Edges[len(conn.Edges)-1].Cursor)
+	}
+
+	return conn
+}
+
 func (t Tag) FromDomain(domain tagdomain.Tag) *Tag {
 	return &Tag{
 		ID:               domain.ID,
@@ -172,6 +390,28 @@ func (c Categories) FromDomain(domain categorydomain.Categories) *Categories {
 	return categories
 }
 
+func (c CategoryConnection) FromDomain(domain categorydomain.ListResponse) *CategoryConnection {
+	conn := &CategoryConnection{
+		Edges: make([]*CategoryEdge, 0, len(domain.Data.Data)),
+		PageInfo: &PageInfo{
+			HasNextPage: domain.HasNextPage,
+		},
+	}
+
+	for i, domainCategory := range domain.Data.Data {
+		conn.Edges = append(conn.Edges, &CategoryEdge{
+			Cursor: domain.Cursors[i].String(),
+			Node:   Category{}.FromDomain(domainCategory),
+		})
+	}
+
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.EndCursor = stringToPointer(conn.Edges[len(conn.Edges)-1].Cursor)
+	}
+
+	return conn
+}
+
 func (c Category) FromDomain(domain categorydomain.Category) *Category {
 	return &Category{
 		ID:               domain.ID,
@@ -236,6 +476,201 @@ func (s PlacedSections) FromDomain(domain sectiondomain.GetPlacedResponse) *Plac
 	return placedSections
 }
 
+func (l Lists) FromDomain(domain listdomain.Lists) *Lists {
+	lists := &Lists{
+		Data:  make([]*List, 0, len(domain.Data)),
+		Total: domain.Total,
+	}
+
+	for _, domainList := range domain.Data {
+		lists.Data = append(lists.Data, List{}.FromDomain(domainList))
+	}
+
+	return lists
+}
+
+func (l List) FromDomain(domain listdomain.List) *List {
+	list := &List{
+		Slug:        domain.Slug,
+		Name:        domain.Name,
+		Description: stringToPointer(domain.Description),
+		Games:       make([]*ListItem, 0, len(domain.Games)),
+	}
+
+	for _, game := range domain.Games {
+		list.Games = append(list.Games, &ListItem{
+			Slug:        game.Slug,
+			Label:       stringToPointer(game.Label),
+			Description: stringToPointer(game.Description),
+			Position:    game.Position,
+			InsertedAt:  game.InsertedAt.String(),
+		})
+	}
+
+	return list
+}
+
+func (r ImportGamesRequest) Domain() importerdomain.ImportRequest {
+	page := 1
+	if r.Page != nil {
+		page = *r.Page
+	}
+
+	return importerdomain.ImportRequest{
+		Provider: r.Provider,
+		Page:     page,
+		Limit:    r.Limit,
+	}
+}
+
+func (r ProviderGamesRequest) Domain() fetcherdomain.ListRequest {
+	req := fetcherdomain.ListRequest{
+		Provider: r.Provider,
+		Page:     r.Page,
+		Limit:    r.Limit,
+	}
+
+	if r.Category != nil {
+		req.Category = *r.Category
+	}
+
+	if r.MobileOnly != nil {
+		req.MobileOnly = *r.MobileOnly
+	}
+
+	if r.Query != nil {
+		req.Query = *r.Query
+	}
+
+	return req
+}
+
+func (r ProviderGamesResponse) FromDomain(domain fetcherdomain.ListResponse) *ProviderGamesResponse {
+	res := &ProviderGamesResponse{
+		Data:         make([]*ProviderGame, 0, len(domain.Data)),
+		Total:        domain.Total,
+		PageFiltered: domain.PageFiltered,
+		HasNextPage:  domain.HasNextPage,
+	}
+
+	for _, g := range domain.Data {
+		game := ProviderGame(g)
+		res.Data = append(res.Data, &game)
+	}
+
+	return res
+}
+
+func (r SendEmailRequest) Domain(ip string) contactdomain.SubmitRequest {
+	return contactdomain.SubmitRequest{
+		IP:       ip,
+		From:     r.From,
+		Name:     r.Name,
+		Subject:  r.Subject,
+		Body:     r.Body,
+		Honeypot: pointerToString(r.Honeypot),
+		Proof:    pointerToString(r.Proof),
+	}
+}
+
+func (r ContactRejectionsRequest) Domain() contactdomain.ListRejectionsRequest {
+	return contactdomain.ListRejectionsRequest{
+		Page:  r.Page,
+		Limit: r.Limit,
+	}
+}
+
+func (r ContactRejectionsResponse) FromDomain(domain contactdomain.ListRejectionsResponse) *ContactRejectionsResponse {
+	res := &ContactRejectionsResponse{
+		Data:  make([]*ContactRejection, 0, len(domain.Data)),
+		Total: domain.Total,
+	}
+
+	for _, r := range domain.Data {
+		res.Data = append(res.Data, &ContactRejection{
+			ID:        r.ID,
+			IP:        r.IP,
+			From:      r.From,
+			Name:      r.Name,
+			Subject:   r.Subject,
+			Body:      r.Body,
+			Reason:    r.Reason.String(),
+			Score:     r.Score,
+			CreatedAt: r.CreatedAt.String(),
+		})
+	}
+
+	return res
+}
+
+func (r GameLivenessRequest) Domain() livenessdomain.ListRequest {
+	req := livenessdomain.ListRequest{
+		Page:  r.Page,
+		Limit: r.Limit,
+	}
+
+	if r.FailingOnly != nil {
+		req.FailingOnly = *r.FailingOnly
+	}
+
+	return req
+}
+
+func (r GameLivenessResponse) FromDomain(domain livenessdomain.ListResponse) *GameLivenessResponse {
+	res := &GameLivenessResponse{
+		Data:  make([]*GameLiveness, 0, len(domain.Data)),
+		Total: domain.Total,
+	}
+
+	for _, s := range domain.Data {
+		l := &GameLiveness{
+			GameID:              s.GameID,
+			URL:                 s.URL,
+			StatusCode:          s.StatusCode,
+			LatencyMs:           int(s.Latency.Milliseconds()),
+			Error:               s.Error,
+			CheckedAt:           s.CheckedAt.String(),
+			ConsecutiveFailures: s.ConsecutiveFailures,
+		}
+
+		if !s.LastSuccessAt.IsZero() {
+			l.LastSuccessAt = stringToPointer(s.LastSuccessAt.String())
+		}
+
+		if !s.DisabledAt.IsZero() {
+			l.DisabledAt = stringToPointer(s.DisabledAt.String())
+		}
+
+		res.Data = append(res.Data, l)
+	}
+
+	return res
+}
+
+func (r ImportGamesResponse) FromDomain(domain importerdomain.ImportResponse) *ImportGamesResponse {
+	res := &ImportGamesResponse{
+		Imported: make([]*ImportedGame, 0, len(domain.Imported)),
+		Skipped:  make([]*SkippedGame, 0, len(domain.Skipped)),
+	}
+
+	for _, g := range domain.Imported {
+		res.Imported = append(res.Imported, &ImportedGame{
+			ID:     g.ID,
+			Slug:   g.Slug,
+			Images: g.Images,
+		})
+	}
+
+	for _, g := range domain.Skipped {
+		res.Skipped = append(res.Skipped, &SkippedGame{
+			Slug:   g.Slug,
+			Reason: g.Reason,
+		})
+	}
+
+	return res
+}
+
 func (s SearchItems) FromDomain(domain searchdomain.SearchResponse) *SearchItems {
 	searchResponse := &SearchItems{
 		Data:  make([]*SearchItem, 0, len(domain.Games)+len(domain.Tags)),
@@ -281,6 +716,59 @@ func (m *SortingMethod) Domain() string {
 	return str
 }
 
+func (r Role) Domain() authdomain.Role {
+	return authdomain.Role(strings.ToLower(r.String()))
+}
+
+func (r GameReaction) Domain() gamedomain.Reaction {
+	return gamedomain.Reaction(strings.ToLower(r.String()))
+}
+
+func (r GameReaction) FromDomain(domain gamedomain.Reaction) GameReaction {
+	switch domain {
+	case gamedomain.ReactionLike:
+		return GameReactionLike
+	case gamedomain.ReactionDislike:
+		return GameReactionDislike
+	}
+
+	return GameReactionNone
+}
+
+func (r RecentlyPlayedGamesRequest) Domain(visitor gamedomain.Visitor) gamedomain.GetRecentlyPlayedRequest {
+	return gamedomain.GetRecentlyPlayedRequest{
+		Visitor:  visitor,
+		Language: gamedomain.Language(r.Language),
+		Page:     r.Page,
+		Limit:    r.Limit,
+	}
+}
+
+func (r RecentlyPlayedGamesResponse) FromDomain(domain gamedomain.GetRecentlyPlayedResponse) *RecentlyPlayedGamesResponse {
+	res := &RecentlyPlayedGamesResponse{
+		Games: make([]*PlayedGame, 0, len(domain.Data)),
+		Total: domain.Total,
+	}
+
+	for _, played := range domain.Data {
+		res.Games = append(res.Games, &PlayedGame{
+			Game:         Game{}.FromDomain(played.Game),
+			Plays:        played.Plays,
+			LastPlayedAt: played.LastPlayedAt.String(),
+		})
+	}
+
+	return res
+}
+
+func (r ReactResponse) FromDomain(domain gamedomain.ReactResponse) *ReactResponse {
+	return &ReactResponse{
+		Reaction: GameReaction("").FromDomain(domain.Reaction),
+		Likes:    domain.Likes,
+		Dislikes: domain.Dislikes,
+	}
+}
+
 func (f *ImageFormat) Domain() imagedomain.Format {
 	if f == nil {
 		return imagedomain.FormatJpg
@@ -369,3 +857,115 @@ func pointerToString(p *string) string {
 
 	return ""
 }
+
+func (r GameRevisionsRequest) Domain() gamedomain.ListRevisionsRequest {
+	return gamedomain.ListRevisionsRequest{
+		ID:    r.GameID,
+		Page:  r.Page,
+		Limit: r.Limit,
+	}
+}
+
+func (r GameRevisionsResponse) FromDomain(domain gamedomain.ListRevisionsResponse) *GameRevisionsResponse {
+	res := &GameRevisionsResponse{
+		Data:  make([]*GameRevision, 0, len(domain.Data)),
+		Total: domain.Total,
+	}
+
+	for _, rev := range domain.Data {
+		res.Data = append(res.Data, &GameRevision{
+			GameID:        rev.GameID,
+			Number:        rev.Number,
+			Action:        rev.Action.String(),
+			Author:        rev.Author,
+			CreatedAt:     rev.CreatedAt.String(),
+			ChangedFields: rev.ChangedFields,
+		})
+	}
+
+	return res
+}
+
+func (c FieldChange) FromDomain(domain gamedomain.FieldChange) *FieldChange {
+	return &FieldChange{
+		Field: domain.Field,
+		From:  domain.From,
+		To:    domain.To,
+	}
+}
+
+func (r ScheduleGameRequest) Domain() (gamedomain.ScheduleRequest, error) {
+	publishAt, err := parseOptionalTime(r.PublishAt)
+	if err != nil {
+		return gamedomain.ScheduleRequest{}, fmt.Errorf("invalid publishAt: %w", err)
+	}
+
+	unpublishAt, err := parseOptionalTime(r.UnpublishAt)
+	if err != nil {
+		return gamedomain.ScheduleRequest{}, fmt.Errorf("invalid unpublishAt: %w", err)
+	}
+
+	return gamedomain.ScheduleRequest{
+		ID:          r.ID,
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+	}, nil
+}
+
+func parseOptionalTime(p *string) (time.Time, error) {
+	if p == nil || *p == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, *p)
+}
+
+func (s GameSchedule) FromDomain(domain gamedomain.Schedule) *GameSchedule {
+	res := &GameSchedule{
+		GameID: domain.GameID,
+	}
+
+	if !domain.PublishAt.IsZero() {
+		res.PublishAt = stringToPointer(domain.PublishAt.String())
+	}
+
+	if !domain.UnpublishAt.IsZero() {
+		res.UnpublishAt = stringToPointer(domain.UnpublishAt.String())
+	}
+
+	return res
+}
+
+func (r GameSchedulesRequest) Domain() gamedomain.ListSchedulesRequest {
+	return gamedomain.ListSchedulesRequest{
+		Page:  r.Page,
+		Limit: r.Limit,
+	}
+}
+
+func (r GameSchedulesResponse) FromDomain(domain gamedomain.ListSchedulesResponse) *GameSchedulesResponse {
+	res := &GameSchedulesResponse{
+		Data:  make([]*GameSchedule, 0, len(domain.Data)),
+		Total: domain.Total,
+	}
+
+	for _, s := range domain.Data {
+		res.Data = append(res.Data, GameSchedule{}.FromDomain(s))
+	}
+
+	return res
+}
+
+func (r RestoreGameRequest) Domain() gamedomain.RestoreRequest {
+	var req gamedomain.RestoreRequest
+
+	if r.ID != nil {
+		req.ID = *r.ID
+	}
+
+	if r.Slug != nil {
+		req.Slug = *r.Slug
+	}
+
+	return req
+}
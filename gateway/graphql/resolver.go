@@ -9,12 +9,16 @@ import (
 	authdomain "github.com/vediagames/platform/auth/domain"
 	bucketdomain "github.com/vediagames/platform/bucket/domain"
 	categorydomain "github.com/vediagames/platform/category/domain"
+	contactdomain "github.com/vediagames/platform/contact/domain"
 	fetcherdomain "github.com/vediagames/platform/fetcher/domain"
 	gamedomain "github.com/vediagames/platform/game/domain"
 	"github.com/vediagames/platform/gateway/graphql/generated"
 	imagedomain "github.com/vediagames/platform/image/domain"
-	notificationdomain "github.com/vediagames/platform/notification/domain"
+	importerdomain "github.com/vediagames/platform/importer/domain"
+	listdomain "github.com/vediagames/platform/list/domain"
+	livenessdomain "github.com/vediagames/platform/liveness/domain"
 	"github.com/vediagames/platform/quote"
+	recommendationdomain "github.com/vediagames/platform/recommendation/domain"
 	searchdomain "github.com/vediagames/platform/search/domain"
 	sectiondomain "github.com/vediagames/platform/section/domain"
 	tagdomain "github.com/vediagames/platform/tag/domain"
@@ -25,33 +29,41 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	gameService     gamedomain.Service
-	categoryService categorydomain.Service
-	sectionService  sectiondomain.Service
-	tagService      tagdomain.Service
-	searchService   searchdomain.Service
-	emailClient     notificationdomain.EmailClient
-	bucketClient    bucketdomain.Client
-	fetcherClient   fetcherdomain.Client
-	authService     authdomain.Service
-	imageService    imagedomain.Service
-	contentURL      string
-	quoteService    quote.Service
+	gameService           gamedomain.Service
+	categoryService       categorydomain.Service
+	sectionService        sectiondomain.Service
+	tagService            tagdomain.Service
+	searchService         searchdomain.Service
+	contactService        contactdomain.Service
+	bucketClient          bucketdomain.Client
+	fetcherClient         fetcherdomain.Client
+	authService           authdomain.Service
+	imageService          imagedomain.Service
+	contentURL            string
+	quoteService          quote.Service
+	listService           listdomain.Service
+	importerService       importerdomain.Service
+	livenessService       livenessdomain.Service
+	recommendationService recommendationdomain.Service
 }
 
 type Config struct {
-	GameService     gamedomain.Service
-	CategoryService categorydomain.Service
-	SectionService  sectiondomain.Service
-	TagService      tagdomain.Service
-	SearchService   searchdomain.Service
-	EmailClient     notificationdomain.EmailClient
-	BucketClient    bucketdomain.Client
-	FetcherClient   fetcherdomain.Client
-	AuthService     authdomain.Service
-	ImageService    imagedomain.Service
-	ContentURL      string
-	QuoteService    quote.Service
+	GameService           gamedomain.Service
+	CategoryService       categorydomain.Service
+	SectionService        sectiondomain.Service
+	TagService            tagdomain.Service
+	SearchService         searchdomain.Service
+	ContactService        contactdomain.Service
+	BucketClient          bucketdomain.Client
+	FetcherClient         fetcherdomain.Client
+	AuthService           authdomain.Service
+	ImageService          imagedomain.Service
+	ContentURL            string
+	QuoteService          quote.Service
+	ListService           listdomain.Service
+	ImporterService       importerdomain.Service
+	LivenessService       livenessdomain.Service
+	RecommendationService recommendationdomain.Service
 }
 
 func (c Config) Validate() error {
@@ -62,13 +74,17 @@ func (c Config) Validate() error {
 	err.AddIf(c.SectionService == nil, fmt.Errorf("section service is required"))
 	err.AddIf(c.TagService == nil, fmt.Errorf("tag service is required"))
 	err.AddIf(c.SearchService == nil, fmt.Errorf("search service is required"))
-	err.AddIf(c.EmailClient == nil, fmt.Errorf("email client is required"))
+	err.AddIf(c.ContactService == nil, fmt.Errorf("contact service is required"))
 	err.AddIf(c.BucketClient == nil, fmt.Errorf("bucket client is required"))
 	err.AddIf(c.FetcherClient == nil, fmt.Errorf("fetcher client is required"))
 	err.AddIf(c.AuthService == nil, fmt.Errorf("auth service is required"))
 	err.AddIf(c.ImageService == nil, fmt.Errorf("image service is required"))
 	err.AddIf(c.ContentURL == "", fmt.Errorf("content URL is required"))
 	err.AddIf(c.QuoteService == nil, fmt.Errorf("quote service is required"))
+	err.AddIf(c.ListService == nil, fmt.Errorf("list service is required"))
+	err.AddIf(c.ImporterService == nil, fmt.Errorf("importer service is required"))
+	err.AddIf(c.LivenessService == nil, fmt.Errorf("liveness service is required"))
+	err.AddIf(c.RecommendationService == nil, fmt.Errorf("recommendation service is required"))
 
 	return err.Err()
 }
@@ -79,23 +95,30 @@ func NewResolver(cfg Config) *Resolver {
 	}
 
 	return &Resolver{
-		gameService:     cfg.GameService,
-		categoryService: cfg.CategoryService,
-		sectionService:  cfg.SectionService,
-		tagService:      cfg.TagService,
-		searchService:   cfg.SearchService,
-		emailClient:     cfg.EmailClient,
-		bucketClient:    cfg.BucketClient,
-		fetcherClient:   cfg.FetcherClient,
-		authService:     cfg.AuthService,
-		imageService:    cfg.ImageService,
-		contentURL:      cfg.ContentURL,
-		quoteService:    cfg.QuoteService,
+		gameService:           cfg.GameService,
+		categoryService:       cfg.CategoryService,
+		sectionService:        cfg.SectionService,
+		tagService:            cfg.TagService,
+		searchService:         cfg.SearchService,
+		contactService:        cfg.ContactService,
+		bucketClient:          cfg.BucketClient,
+		fetcherClient:         cfg.FetcherClient,
+		authService:           cfg.AuthService,
+		imageService:          cfg.ImageService,
+		contentURL:            cfg.ContentURL,
+		quoteService:          cfg.QuoteService,
+		listService:           cfg.ListService,
+		importerService:       cfg.ImporterService,
+		livenessService:       cfg.LivenessService,
+		recommendationService: cfg.RecommendationService,
 	}
 }
 
 func NewSchema(r *Resolver) graphql.ExecutableSchema {
 	return generated.NewExecutableSchema(generated.Config{
 		Resolvers: r,
+		Directives: generated.DirectiveRoot{
+			HasRole: r.hasRole,
+		},
 	})
 }
@@ -8,12 +8,13 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/rs/zerolog"
 	categorydomain "github.com/vediagames/platform/category/domain"
+	contactdomain "github.com/vediagames/platform/contact/domain"
 	gamedomain "github.com/vediagames/platform/game/domain"
 	"github.com/vediagames/platform/gateway/graphql/generated"
 	"github.com/vediagames/platform/gateway/graphql/model"
-	notificationdomain "github.com/vediagames/platform/notification/domain"
+	listdomain "github.com/vediagames/platform/list/domain"
+	recommendationdomain "github.com/vediagames/platform/recommendation/domain"
 	searchdomain "github.com/vediagames/platform/search/domain"
 	sectiondomain "github.com/vediagames/platform/section/domain"
 	tagdomain "github.com/vediagames/platform/tag/domain"
@@ -21,21 +22,53 @@ import (
 
 // SendEmail is the resolver for the sendEmail field.
 func (r *mutationResolver) SendEmail(ctx context.Context, request model.SendEmailRequest) (bool, error) {
-	err := r.emailClient.Email(ctx, notificationdomain.EmailRequest{
-		To: notificationdomain.User{
-			Email: "antonio.jelic@vedia.si",
-			Name:  "Antonio Jelic",
-		},
-		From: notificationdomain.User{
-			Email: request.From,
-			Name:  "platform Contact form",
-		},
-		Name:    request.Name,
-		Subject: request.Subject,
-		Body:    request.Body,
+	res, err := r.contactService.Submit(ctx, request.Domain(contactdomain.IPFromContext(ctx)))
+	if err != nil {
+		return false, fmt.Errorf("failed to submit: %w", err)
+	}
+
+	if !res.Accepted && res.Reason.Disclosed() {
+		return false, fmt.Errorf("%w: %s", contactdomain.ErrRejected, res.Reason)
+	}
+
+	return true, nil
+}
+
+// React is the resolver for the react field.
+func (r *mutationResolver) React(ctx context.Context, gameID int, reaction model.GameReaction) (*model.ReactResponse, error) {
+	visitor, ok := r.visitor(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no session or user", gamedomain.ErrInvalidVisitor)
+	}
+
+	reactRes, err := r.gameService.React(ctx, gamedomain.ReactRequest{
+		ID:       gameID,
+		Visitor:  visitor,
+		Reaction: reaction.Domain(),
 	})
 	if err != nil {
-		return false, fmt.Errorf("failed to email: %w", err)
+		return nil, fmt.Errorf("failed to react: %w", err)
+	}
+
+	return model.ReactResponse{}.FromDomain(reactRes), nil
+}
+
+// Play is the resolver for the play field.
+func (r *mutationResolver) Play(ctx context.Context, gameID int) (bool, error) {
+	// Plays are only counted for a signed in user or a verified session.
+	visitor, ok := r.visitor(ctx)
+	if !ok {
+		return false, fmt.Errorf("%w: no session", gamedomain.ErrInvalidVisitor)
+	}
+
+	err := r.gameService.LogEvent(ctx, gamedomain.LogEventRequest{
+		ID:      gameID,
+		Event:   gamedomain.EventPlay,
+		Visitor: visitor,
+		IP:      contactdomain.IPFromContext(ctx),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to log event: %w", err)
 	}
 
 	return true, nil
@@ -43,7 +76,10 @@ func (r *mutationResolver) SendEmail(ctx context.Context, request model.SendEmai
 
 // CreateGame is the resolver for the createGame field.
 func (r *mutationResolver) CreateGame(ctx context.Context, request model.CreateGameRequest) (*model.CreateGameResponse, error) {
-	gameRes, err := r.gameService.Create(ctx, request.Domain())
+	req := request.Domain()
+	req.Author = r.author(ctx)
+
+	gameRes, err := r.gameService.Create(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create: %w", err)
 	}
@@ -55,7 +91,10 @@ func (r *mutationResolver) CreateGame(ctx context.Context, request model.CreateG
 
 // UpdateGame is the resolver for the updateGame field.
 func (r *mutationResolver) UpdateGame(ctx context.Context, request model.UpdateGameRequest) (*model.UpdateGameResponse, error) {
-	gameRes, err := r.gameService.Edit(ctx, request.Domain())
+	req := request.Domain()
+	req.Author = r.author(ctx)
+
+	gameRes, err := r.gameService.Edit(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to edit: %w", err)
 	}
@@ -74,11 +113,13 @@ func (r *mutationResolver) DeleteGame(ctx context.Context, request model.DeleteG
 	switch {
 	case request.ID != nil:
 		_, err = r.gameService.Remove(ctx, gamedomain.RemoveRequest{
-			ID: *request.ID,
+			ID:     *request.ID,
+			Author: r.author(ctx),
 		})
 	case request.Slug != nil:
 		_, err = r.gameService.Remove(ctx, gamedomain.RemoveRequest{
-			Slug: *request.Slug,
+			Slug:   *request.Slug,
+			Author: r.author(ctx),
 		})
 	}
 
@@ -89,6 +130,240 @@ func (r *mutationResolver) DeleteGame(ctx context.Context, request model.DeleteG
 	return true, nil
 }
 
+// RestoreGame is the resolver for the restoreGame field.
+func (r *mutationResolver) RestoreGame(ctx context.Context, request model.RestoreGameRequest) (*model.UpdateGameResponse, error) {
+	req := request.Domain()
+	req.Author = r.author(ctx)
+
+	gameRes, err := r.gameService.Restore(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore: %w", err)
+	}
+
+	return &model.UpdateGameResponse{
+		Game: model.Game{}.FromDomain(gameRes.Data),
+	}, nil
+}
+
+// RollbackGame is the resolver for the rollbackGame field.
+func (r *mutationResolver) RollbackGame(ctx context.Context, id int, revision int) (*model.UpdateGameResponse, error) {
+	gameRes, err := r.gameService.Rollback(ctx, gamedomain.RollbackRequest{
+		ID:       id,
+		Revision: revision,
+		Author:   r.author(ctx),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rollback: %w", err)
+	}
+
+	return &model.UpdateGameResponse{
+		Game: model.Game{}.FromDomain(gameRes.Data),
+	}, nil
+}
+
+// ScheduleGame is the resolver for the scheduleGame field.
+func (r *mutationResolver) ScheduleGame(ctx context.Context, request model.ScheduleGameRequest) (*model.ScheduleGameResponse, error) {
+	req, err := request.Domain()
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	req.Author = r.author(ctx)
+
+	scheduleRes, err := r.gameService.Schedule(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule: %w", err)
+	}
+
+	return &model.ScheduleGameResponse{
+		Game:     model.Game{}.FromDomain(scheduleRes.Data),
+		Schedule: model.GameSchedule{}.FromDomain(scheduleRes.Schedule),
+	}, nil
+}
+
+// UnscheduleGame is the resolver for the unscheduleGame field.
+func (r *mutationResolver) UnscheduleGame(ctx context.Context, id int) (*model.UpdateGameResponse, error) {
+	gameRes, err := r.gameService.Unschedule(ctx, gamedomain.UnscheduleRequest{
+		ID:     id,
+		Author: r.author(ctx),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unschedule: %w", err)
+	}
+
+	return &model.UpdateGameResponse{
+		Game: model.Game{}.FromDomain(gameRes.Data),
+	}, nil
+}
+
+// AddGameToList is the resolver for the addGameToList field.
+func (r *mutationResolver) AddGameToList(ctx context.Context, request model.AddGameToListRequest) (*model.ListResponse, error) {
+	listRes, err := r.listService.AddGame(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add game: %w", err)
+	}
+
+	return &model.ListResponse{
+		List: model.List{}.FromDomain(listRes.Data),
+	}, nil
+}
+
+// RemoveGameFromList is the resolver for the removeGameFromList field.
+func (r *mutationResolver) RemoveGameFromList(ctx context.Context, request model.RemoveGameFromListRequest) (*model.ListResponse, error) {
+	listRes, err := r.listService.RemoveGame(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove game: %w", err)
+	}
+
+	return &model.ListResponse{
+		List: model.List{}.FromDomain(listRes.Data),
+	}, nil
+}
+
+// ReorderList is the resolver for the reorderList field.
+func (r *mutationResolver) ReorderList(ctx context.Context, request model.ReorderListRequest) (*model.ListResponse, error) {
+	listRes, err := r.listService.Reorder(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to reorder: %w", err)
+	}
+
+	return &model.ListResponse{
+		List: model.List{}.FromDomain(listRes.Data),
+	}, nil
+}
+
+// CreateSection is the resolver for the createSection field.
+func (r *mutationResolver) CreateSection(ctx context.Context, request model.CreateSectionRequest) (*model.CreateSectionResponse, error) {
+	sectionRes, err := r.sectionService.Create(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create: %w", err)
+	}
+
+	return &model.CreateSectionResponse{
+		Section: model.Section{}.FromDomain(sectionRes.Data),
+	}, nil
+}
+
+// UpdateSection is the resolver for the updateSection field.
+func (r *mutationResolver) UpdateSection(ctx context.Context, request model.UpdateSectionRequest) (*model.UpdateSectionResponse, error) {
+	sectionRes, err := r.sectionService.Edit(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit: %w", err)
+	}
+
+	return &model.UpdateSectionResponse{
+		Section: model.Section{}.FromDomain(sectionRes.Data),
+	}, nil
+}
+
+// DeleteSection is the resolver for the deleteSection field.
+func (r *mutationResolver) DeleteSection(ctx context.Context, request model.DeleteSectionRequest) (bool, error) {
+	if _, err := r.sectionService.Remove(ctx, request.Domain()); err != nil {
+		return false, fmt.Errorf("failed to remove: %w", err)
+	}
+
+	return true, nil
+}
+
+// UpdatePlacedSections is the resolver for the updatePlacedSections field.
+func (r *mutationResolver) UpdatePlacedSections(ctx context.Context, placements []*model.PlacementInput) (bool, error) {
+	req := sectiondomain.EditPlacedRequest{
+		Placements: make(map[sectiondomain.Placement]int, len(placements)),
+	}
+
+	for _, p := range placements {
+		placement := sectiondomain.Placement(p.Placement)
+
+		if _, ok := req.Placements[placement]; ok {
+			return false, fmt.Errorf("duplicate placement %d", p.Placement)
+		}
+
+		req.Placements[placement] = p.SectionID
+	}
+
+	if err := r.sectionService.EditPlaced(ctx, req); err != nil {
+		return false, fmt.Errorf("failed to edit placed sections: %w", err)
+	}
+
+	return true, nil
+}
+
+// CreateTag is the resolver for the createTag field.
+func (r *mutationResolver) CreateTag(ctx context.Context, request model.CreateTagRequest) (*model.CreateTagResponse, error) {
+	tagRes, err := r.tagService.Create(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create: %w", err)
+	}
+
+	return &model.CreateTagResponse{
+		Tag: model.Tag{}.FromDomain(tagRes.Data),
+	}, nil
+}
+
+// UpdateTag is the resolver for the updateTag field.
+func (r *mutationResolver) UpdateTag(ctx context.Context, request model.UpdateTagRequest) (*model.UpdateTagResponse, error) {
+	tagRes, err := r.tagService.Edit(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit: %w", err)
+	}
+
+	return &model.UpdateTagResponse{
+		Tag: model.Tag{}.FromDomain(tagRes.Data),
+	}, nil
+}
+
+// DeleteTag is the resolver for the deleteTag field.
+func (r *mutationResolver) DeleteTag(ctx context.Context, request model.DeleteTagRequest) (bool, error) {
+	if _, err := r.tagService.Remove(ctx, request.Domain()); err != nil {
+		return false, fmt.Errorf("failed to remove: %w", err)
+	}
+
+	return true, nil
+}
+
+// CreateCategory is the resolver for the createCategory field.
+func (r *mutationResolver) CreateCategory(ctx context.Context, request model.CreateCategoryRequest) (*model.CreateCategoryResponse, error) {
+	categoryRes, err := r.categoryService.Create(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create: %w", err)
+	}
+
+	return &model.CreateCategoryResponse{
+		Category: model.Category{}.FromDomain(categoryRes.Data),
+	}, nil
+}
+
+// UpdateCategory is the resolver for the updateCategory field.
+func (r *mutationResolver) UpdateCategory(ctx context.Context, request model.UpdateCategoryRequest) (*model.UpdateCategoryResponse, error) {
+	categoryRes, err := r.categoryService.Edit(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit: %w", err)
+	}
+
+	return &model.UpdateCategoryResponse{
+		Category: model.Category{}.FromDomain(categoryRes.Data),
+	}, nil
+}
+
+// DeleteCategory is the resolver for the deleteCategory field.
+func (r *mutationResolver) DeleteCategory(ctx context.Context, request model.DeleteCategoryRequest) (bool, error) {
+	if _, err := r.categoryService.Remove(ctx, request.Domain()); err != nil {
+		return false, fmt.Errorf("failed to remove: %w", err)
+	}
+
+	return true, nil
+}
+
+// ImportGames is the resolver for the importGames field.
+func (r *mutationResolver) ImportGames(ctx context.Context, request model.ImportGamesRequest) (*model.ImportGamesResponse, error) {
+	importRes, err := r.importerService.Import(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to import: %w", err)
+	}
+
+	return model.ImportGamesResponse{}.FromDomain(importRes), nil
+}
+
 // MostPlayedGames is the resolver for the mostPlayedGames field.
 func (r *queryResolver) MostPlayedGames(ctx context.Context, request model.MostPlayedGamesRequest) (*model.MostPlayedGamesResponse, error) {
 	gameRes, err := r.gameService.GetMostPlayedByDays(ctx, gamedomain.GetMostPlayedByDaysRequest{
@@ -154,6 +429,39 @@ func (r *queryResolver) Games(ctx context.Context, request model.GamesRequest) (
 	}, nil
 }
 
+// GamesConnection is the resolver for the gamesConnection field.
+func (r *queryResolver) GamesConnection(ctx context.Context, request model.GamesConnectionRequest) (*model.GameConnection, error) {
+	var after gamedomain.Cursor
+
+	if request.After != nil {
+		var err error
+
+		after, err = gamedomain.ParseCursor(*request.After)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cursor: %w", err)
+		}
+	}
+
+	gameRes, err := r.gameService.List(ctx, gamedomain.ListRequest{
+		Language:       gamedomain.Language(request.Language),
+		First:          request.First,
+		After:          after,
+		AllowDeleted:   request.AllowDeleted,
+		AllowInvisible: request.AllowInvisible,
+		Sort:           gamedomain.SortingMethod(request.Sort.Domain()),
+		CategoryIDRefs: request.Categories,
+		TagIDRefs:      request.Tags,
+		IDRefs:         request.Ids,
+		ExcludedIDRefs: request.ExcludedGameIDs,
+		Slugs:          request.Slugs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+
+	return model.GameConnection{}.FromDomain(gameRes), nil
+}
+
 // Game is the resolver for the game field.
 func (r *queryResolver) Game(ctx context.Context, request model.GameRequest) (*model.GameResponse, error) {
 	gameRes, err := r.gameService.Get(ctx, gamedomain.GetRequest{
@@ -165,8 +473,70 @@ func (r *queryResolver) Game(ctx context.Context, request model.GameRequest) (*m
 		return nil, fmt.Errorf("failed to get: %w", err)
 	}
 
+	// Scheduled games are not out yet, only editors may see them.
+	if gameRes.Data.Status == gamedomain.StatusScheduled && !r.isEditor(ctx) {
+		return nil, fmt.Errorf("failed to get: %w", gamedomain.ErrNoData)
+	}
+
+	var redirectTo *string
+	if gameRes.RedirectTo != "" {
+		redirectTo = &gameRes.RedirectTo
+	}
+
 	return &model.GameResponse{
-		Game: model.Game{}.FromDomain(gameRes.Data),
+		Game:       model.Game{}.FromDomain(gameRes.Data),
+		RedirectTo: redirectTo,
+	}, nil
+}
+
+// GameReaction is the resolver for the gameReaction field.
+func (r *queryResolver) GameReaction(ctx context.Context, gameID int) (model.GameReaction, error) {
+	visitor, ok := r.visitor(ctx)
+	if !ok {
+		return model.GameReactionNone, nil
+	}
+
+	reactionsRes, err := r.gameService.GetReactions(ctx, gamedomain.GetReactionsRequest{
+		IDs:     []int{gameID},
+		Visitor: visitor,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get reactions: %w", err)
+	}
+
+	return model.GameReaction("").FromDomain(reactionsRes.Data[gameID]), nil
+}
+
+// RecentlyPlayedGames is the resolver for the recentlyPlayedGames field.
+func (r *queryResolver) RecentlyPlayedGames(ctx context.Context, request model.RecentlyPlayedGamesRequest) (*model.RecentlyPlayedGamesResponse, error) {
+	visitor, ok := r.visitor(ctx)
+	if !ok {
+		return &model.RecentlyPlayedGamesResponse{
+			Games: []*model.PlayedGame{},
+		}, nil
+	}
+
+	playedRes, err := r.gameService.GetRecentlyPlayed(ctx, request.Domain(visitor))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recently played: %w", err)
+	}
+
+	return model.RecentlyPlayedGamesResponse{}.FromDomain(playedRes), nil
+}
+
+// SimilarGames is the resolver for the similarGames field.
+func (r *queryResolver) SimilarGames(ctx context.Context, gameID int, limit int, language model.Language) (*model.GamesResponse, error) {
+	similarRes, err := r.recommendationService.Similar(ctx, recommendationdomain.SimilarRequest{
+		ID:       gameID,
+		Language: gamedomain.Language(language),
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get similar games: %w", err)
+	}
+
+	return &model.GamesResponse{
+		Games: model.Games{}.FromDomain(similarRes.Data),
 	}, nil
 }
 
@@ -175,54 +545,35 @@ func (r *queryResolver) TrendingGames(ctx context.Context, language model.Langua
 	// TODO: BL logic should be in game service.
 	const amountOfGamesNeeded = 15
 
-	res := make([]*model.ListGame, 0, amountOfGamesNeeded)
-
-	selectedGamesRes, err := r.gameService.List(ctx, gamedomain.ListRequest{
-		Language: gamedomain.Language(language),
-		Page:     1,
-		Limit:    len(trendingGames),
-		Sort:     gamedomain.SortingMethodMostPopular,
-		Slugs:    trendingGames.Slugs(),
-	})
+	res, err := r.listGames(ctx, language, listSlugTrending)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get games: %w", err)
+		return nil, fmt.Errorf("failed to get list games: %w", err)
 	}
 
-	// Second batch of games, if the amount needed is not fulfilled.
-	var randomGamesRes gamedomain.ListResponse
-	if len(selectedGamesRes.Data.Data) < amountOfGamesNeeded {
-		amountToFetch := amountOfGamesNeeded - len(selectedGamesRes.Data.Data)
+	// Second batch of games from the trending snapshot, if the amount needed
+	// is not fulfilled by editors.
+	if len(res) < amountOfGamesNeeded {
+		excludedIDs := make([]int, 0, len(res))
+		for _, listGame := range res {
+			excludedIDs = append(excludedIDs, listGame.Game.ID)
+		}
 
-		randomGamesRes, err = r.gameService.List(ctx, gamedomain.ListRequest{
+		trendingGamesRes, err := r.gameService.List(ctx, gamedomain.ListRequest{
 			Language:       gamedomain.Language(language),
 			Page:           1,
-			Limit:          amountToFetch,
-			Sort:           gamedomain.SortingMethodRandom,
-			ExcludedIDRefs: selectedGamesRes.Data.IDs(),
+			Limit:          amountOfGamesNeeded - len(res),
+			Sort:           gamedomain.SortingMethodTrending,
+			ExcludedIDRefs: excludedIDs,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to get more games: %w", err)
 		}
-	}
 
-	for _, game := range selectedGamesRes.Data.Data {
-		trendingGame := trendingGames.FindBySlug(game.Slug)
-		if trendingGame.IsZero() {
-			zerolog.Ctx(ctx).Warn().Str("slug", game.Slug).Msg("trending game not found")
-			continue
+		for _, game := range trendingGamesRes.Data.Data {
+			res = append(res, &model.ListGame{
+				Game: model.Game{}.FromDomain(game),
+			})
 		}
-
-		res = append(res, &model.ListGame{
-			Game:        model.Game{}.FromDomain(game),
-			Label:       &trendingGame.Label,
-			Description: &trendingGame.Description,
-		})
-	}
-
-	for _, game := range randomGamesRes.Data.Data {
-		res = append(res, &model.ListGame{
-			Game: model.Game{}.FromDomain(game),
-		})
 	}
 
 	return res, nil
@@ -230,77 +581,49 @@ func (r *queryResolver) TrendingGames(ctx context.Context, language model.Langua
 
 // PromotedGame is the resolver for the promotedGame field.
 func (r *queryResolver) PromotedGame(ctx context.Context, language model.Language) (*model.ListGame, error) {
-	gamesRes, err := r.Games(ctx, model.GamesRequest{
-		Language: language,
-		Page:     1,
-		Limit:    1,
-		Slugs:    []string{promotedGame.Slug},
-	})
+	game, err := r.firstListGame(ctx, language, listSlugPromoted)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get games: %w", err)
+		return nil, fmt.Errorf("failed to get promoted game: %w", err)
 	}
 
-	if len(gamesRes.Games.Data) == 0 {
-		return nil, fmt.Errorf("promoted game with slug %q not found", promotedGame.Slug)
-	}
-
-	return &model.ListGame{
-		Game:        gamesRes.Games.Data[0],
-		Label:       &promotedGame.Label,
-		Description: &promotedGame.Description,
-	}, nil
+	return game, nil
 }
 
 // PopularGames is the resolver for the popularGames field.
 func (r *queryResolver) PopularGames(ctx context.Context, language model.Language) ([]*model.ListGame, error) {
 	const amountOfGamesNeeded = 4
 
-	sort := model.SortingMethodMostPopular
-	res := make([]*model.ListGame, 0, amountOfGamesNeeded)
-	fullGames := make([]*model.Game, 0, amountOfGamesNeeded)
-
-	gamesRes, err := r.Games(ctx, model.GamesRequest{
-		Language: language,
-		Page:     1,
-		Limit:    4,
-		Sort:     &sort,
-		Slugs:    popularGames.Slugs(),
-	})
+	res, err := r.listGames(ctx, language, listSlugPopular)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get games: %w", err)
+		return nil, fmt.Errorf("failed to get list games: %w", err)
 	}
 
-	fullGames = append(fullGames, gamesRes.Games.Data...)
+	if len(res) > amountOfGamesNeeded {
+		res = res[:amountOfGamesNeeded]
+	}
 
-	if len(fullGames) < amountOfGamesNeeded {
-		amountToFetch := amountOfGamesNeeded - len(gamesRes.Games.Data)
+	if len(res) < amountOfGamesNeeded {
+		excludedIDs := make([]int, 0, len(res))
+		for _, listGame := range res {
+			excludedIDs = append(excludedIDs, listGame.Game.ID)
+		}
 
-		gamesRes, err = r.Games(ctx, model.GamesRequest{
-			Language:        language,
-			Page:            1,
-			Limit:           amountToFetch,
-			Sort:            &sort,
-			ExcludedGameIDs: gamesRes.Games.IDs(),
+		gamesRes, err := r.gameService.List(ctx, gamedomain.ListRequest{
+			Language:       gamedomain.Language(language),
+			Page:           1,
+			Limit:          amountOfGamesNeeded - len(res),
+			Sort:           gamedomain.SortingMethodMostPopular,
+			ExcludedIDRefs: excludedIDs,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to get more games: %w", err)
 		}
 
-		fullGames = append(fullGames, gamesRes.Games.Data...)
-	}
-
-	for _, game := range fullGames {
-		popularGame := popularGames.FindBySlug(game.Slug)
-		if popularGame.IsZero() {
-			zerolog.Ctx(ctx).Warn().Str("slug", game.Slug).Msg("popular game not found")
-			continue
+		for _, game := range gamesRes.Data.Data {
+			res = append(res, &model.ListGame{
+				Game: model.Game{}.FromDomain(game),
+			})
 		}
-
-		res = append(res, &model.ListGame{
-			Game:        game,
-			Label:       &popularGame.Label,
-			Description: &popularGame.Description,
-		})
 	}
 
 	return res, nil
@@ -308,24 +631,40 @@ func (r *queryResolver) PopularGames(ctx context.Context, language model.Languag
 
 // PickedByEditor is the resolver for the pickedByEditor field.
 func (r *queryResolver) PickedByEditor(ctx context.Context, language model.Language) (*model.ListGame, error) {
-	gamesRes, err := r.Games(ctx, model.GamesRequest{
-		Language: language,
-		Page:     1,
-		Limit:    1,
-		Slugs:    []string{pickedByEditor.Slug},
+	game, err := r.firstListGame(ctx, language, listSlugPickedByEditor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get picked by editor game: %w", err)
+	}
+
+	return game, nil
+}
+
+// Lists is the resolver for the lists field.
+func (r *queryResolver) Lists(ctx context.Context, request model.ListsRequest) (*model.ListsResponse, error) {
+	listRes, err := r.listService.List(ctx, listdomain.ListRequest{
+		Page:  request.Page,
+		Limit: request.Limit,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get games: %w", err)
+		return nil, fmt.Errorf("failed to list: %w", err)
 	}
 
-	if len(gamesRes.Games.Data) == 0 {
-		return nil, fmt.Errorf("picked by editor game with slug %q not found", pickedByEditor.Slug)
+	return &model.ListsResponse{
+		Lists: model.Lists{}.FromDomain(listRes.Data),
+	}, nil
+}
+
+// List is the resolver for the list field.
+func (r *queryResolver) List(ctx context.Context, request model.ListRequest) (*model.ListResponse, error) {
+	listRes, err := r.listService.Get(ctx, listdomain.GetRequest{
+		Slug: request.Slug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
 	}
 
-	return &model.ListGame{
-		Game:        gamesRes.Games.Data[0],
-		Label:       &pickedByEditor.Label,
-		Description: &pickedByEditor.Description,
+	return &model.ListResponse{
+		List: model.List{}.FromDomain(listRes.Data),
 	}, nil
 }
 
@@ -347,6 +686,33 @@ func (r *queryResolver) Categories(ctx context.Context, request model.Categories
 	}, nil
 }
 
+// CategoriesConnection is the resolver for the categoriesConnection field.
+func (r *queryResolver) CategoriesConnection(ctx context.Context, request model.CategoriesConnectionRequest) (*model.CategoryConnection, error) {
+	var after categorydomain.Cursor
+
+	if request.After != nil {
+		var err error
+
+		after, err = categorydomain.ParseCursor(*request.After)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cursor: %w", err)
+		}
+	}
+
+	categoryRes, err := r.categoryService.List(ctx, categorydomain.ListRequest{
+		Language:       categorydomain.Language(request.Language),
+		First:          request.First,
+		After:          after,
+		AllowDeleted:   request.AllowDeleted,
+		AllowInvisible: request.AllowInvisible,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+
+	return model.CategoryConnection{}.FromDomain(categoryRes), nil
+}
+
 // Category is the resolver for the category field.
 func (r *queryResolver) Category(ctx context.Context, request model.CategoryRequest) (*model.CategoryResponse, error) {
 	categoryRes, err := r.categoryService.Get(ctx, categorydomain.GetRequest{
@@ -358,8 +724,14 @@ func (r *queryResolver) Category(ctx context.Context, request model.CategoryRequ
 		return nil, fmt.Errorf("failed to get: %w", err)
 	}
 
+	var redirectTo *string
+	if categoryRes.RedirectTo != "" {
+		redirectTo = &categoryRes.RedirectTo
+	}
+
 	return &model.CategoryResponse{
-		Category: model.Category{}.FromDomain(categoryRes.Data),
+		Category:   model.Category{}.FromDomain(categoryRes.Data),
+		RedirectTo: redirectTo,
 	}, nil
 }
 
@@ -382,6 +754,34 @@ func (r *queryResolver) Tags(ctx context.Context, request model.TagsRequest) (*m
 	}, nil
 }
 
+// TagsConnection is the resolver for the tagsConnection field.
+func (r *queryResolver) TagsConnection(ctx context.Context, request model.TagsConnectionRequest) (*model.TagConnection, error) {
+	var after tagdomain.Cursor
+
+	if request.After != nil {
+		var err error
+
+		after, err = tagdomain.ParseCursor(*request.After)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cursor: %w", err)
+		}
+	}
+
+	tagRes, err := r.tagService.List(ctx, tagdomain.ListRequest{
+		Language:       tagdomain.Language(request.Language),
+		First:          request.First,
+		After:          after,
+		AllowDeleted:   request.AllowDeleted,
+		AllowInvisible: request.AllowInvisible,
+		Sort:           tagdomain.SortingMethod(request.Sort.Domain()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+
+	return model.TagConnection{}.FromDomain(tagRes), nil
+}
+
 // Tag is the resolver for the tag field.
 func (r *queryResolver) Tag(ctx context.Context, request model.TagRequest) (*model.TagResponse, error) {
 	tagRes, err := r.tagService.Get(ctx, tagdomain.GetRequest{
@@ -393,8 +793,14 @@ func (r *queryResolver) Tag(ctx context.Context, request model.TagRequest) (*mod
 		return nil, fmt.Errorf("failed to get: %w", err)
 	}
 
+	var redirectTo *string
+	if tagRes.RedirectTo != "" {
+		redirectTo = &tagRes.RedirectTo
+	}
+
 	return &model.TagResponse{
-		Tag: model.Tag{}.FromDomain(tagRes.Data),
+		Tag:        model.Tag{}.FromDomain(tagRes.Data),
+		RedirectTo: redirectTo,
 	}, nil
 }
 
@@ -489,7 +895,7 @@ func (r *queryResolver) FullSearch(ctx context.Context, request model.FullSearch
 
 // RandomProviderGame is the resolver for the randomProviderGame field.
 func (r *queryResolver) RandomProviderGame(ctx context.Context) (*model.RandomProviderGameResponse, error) {
-	fetcherRes, err := r.fetcherClient.Fetch()
+	fetcherRes, err := r.fetcherClient.Fetch(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch game: %w", err)
 	}
@@ -499,6 +905,75 @@ func (r *queryResolver) RandomProviderGame(ctx context.Context) (*model.RandomPr
 	return &res, nil
 }
 
+// ProviderGames is the resolver for the providerGames field.
+func (r *queryResolver) ProviderGames(ctx context.Context, request model.ProviderGamesRequest) (*model.ProviderGamesResponse, error) {
+	listRes, err := r.fetcherClient.List(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list provider games: %w", err)
+	}
+
+	return model.ProviderGamesResponse{}.FromDomain(listRes), nil
+}
+
+// GameLiveness is the resolver for the gameLiveness field.
+func (r *queryResolver) GameLiveness(ctx context.Context, request model.GameLivenessRequest) (*model.GameLivenessResponse, error) {
+	listRes, err := r.livenessService.List(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+
+	return model.GameLivenessResponse{}.FromDomain(listRes), nil
+}
+
+// ContactRejections is the resolver for the contactRejections field.
+func (r *queryResolver) ContactRejections(ctx context.Context, request model.ContactRejectionsRequest) (*model.ContactRejectionsResponse, error) {
+	listRes, err := r.contactService.ListRejections(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+
+	return model.ContactRejectionsResponse{}.FromDomain(listRes), nil
+}
+
+// GameRevisions is the resolver for the gameRevisions field.
+func (r *queryResolver) GameRevisions(ctx context.Context, request model.GameRevisionsRequest) (*model.GameRevisionsResponse, error) {
+	listRes, err := r.gameService.ListRevisions(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	return model.GameRevisionsResponse{}.FromDomain(listRes), nil
+}
+
+// GameRevisionDiff is the resolver for the gameRevisionDiff field.
+func (r *queryResolver) GameRevisionDiff(ctx context.Context, gameID int, from int, to int) ([]*model.FieldChange, error) {
+	diffRes, err := r.gameService.DiffRevisions(ctx, gamedomain.DiffRevisionsRequest{
+		ID:   gameID,
+		From: from,
+		To:   to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff revisions: %w", err)
+	}
+
+	res := make([]*model.FieldChange, 0, len(diffRes.Data))
+	for _, change := range diffRes.Data {
+		res = append(res, model.FieldChange{}.FromDomain(change))
+	}
+
+	return res, nil
+}
+
+// GameSchedules is the resolver for the gameSchedules field.
+func (r *queryResolver) GameSchedules(ctx context.Context, request model.GameSchedulesRequest) (*model.GameSchedulesResponse, error) {
+	listRes, err := r.gameService.ListSchedules(ctx, request.Domain())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	return model.GameSchedulesResponse{}.FromDomain(listRes), nil
+}
+
 // AvailableLanguages is the resolver for the availableLanguages field.
 func (r *queryResolver) AvailableLanguages(ctx context.Context) (*model.AvailableLanguagesResponse, error) {
 	return &model.AvailableLanguagesResponse{
@@ -0,0 +1,23 @@
+package graphql
+
+import (
+	"context"
+
+	gamedomain "github.com/vediagames/platform/game/domain"
+	sessiondomain "github.com/vediagames/platform/session/domain"
+)
+
+// visitor identifies the caller for per-visitor state such as reactions and
+// play history. A signed in user is preferred over the anonymous session, so
+// that state follows the user across devices.
+func (r *Resolver) visitor(ctx context.Context) (gamedomain.Visitor, bool) {
+	if user, err := r.authService.FromContext(ctx); err == nil {
+		return gamedomain.VisitorFromUser(user.ID), true
+	}
+
+	if id := sessiondomain.IDFromContext(ctx); id != "" {
+		return gamedomain.VisitorFromSession(id), true
+	}
+
+	return "", false
+}
@@ -13,6 +13,22 @@ resource "google_pubsub_topic" "example" {
     encoding = "JSON"
   }
 }
+
+resource "google_pubsub_schema" "platform" {
+  name       = "platform"
+  type       = "PROTOCOL_BUFFER"
+  definition = file(var.pubsub_platform_schema_path)
+}
+
+resource "google_pubsub_topic" "platform_events" {
+  name = "platform-events"
+
+  depends_on = [google_pubsub_schema.platform]
+  schema_settings {
+    schema   = "projects/${var.project_id}/schemas/${google_pubsub_schema.platform.name}"
+    encoding = "JSON"
+  }
+}
 #
 #resource "google_pubsub_subscription" "example" {
 #  name  = "example-subscription"
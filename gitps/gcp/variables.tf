@@ -93,6 +93,11 @@ variable "pubsub_example_schema_path" {
   description = "File path for Pub/Sub message schema for 'example' topic (JSON)"
 }
 
+variable "pubsub_platform_schema_path" {
+  type = string
+  description = "File path for Pub/Sub message schema for 'platform-events' topic (db/schema/pubsub/platform.proto)"
+}
+
 variable "authorized_source_ranges" {
   type = list(string)
   description = "A list of CIDR addresses that are authorized to connect to GKE"
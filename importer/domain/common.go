@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Kind is what a provider category or tag string is mapped to.
+type Kind string
+
+func (k Kind) Validate() error {
+	switch k {
+	case KindTag, KindCategory:
+		return nil
+	}
+
+	return fmt.Errorf("invalid value: %q", k)
+}
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+const (
+	KindTag      Kind = "tag"
+	KindCategory Kind = "category"
+)
+
+type Imported struct {
+	ID     int
+	Slug   string
+	Images int
+}
+
+type Skipped struct {
+	Slug   string
+	Reason string
+}
+
+type Link struct {
+	GameID       int
+	Provider     string
+	ProviderID   string
+	SyncedAt     time.Time
+	MissingSince time.Time
+}
+
+type UnlinkedGame struct {
+	ID  int
+	URL string
+}
+
+// SyncedGame is a game a sync run changed.
+type SyncedGame struct {
+	ID   int
+	Slug string
+}
+
+type Change struct {
+	Field string
+	Old   string
+	New   string
+}
+
+type Updated struct {
+	ID      int
+	Slug    string
+	Changes []Change
+}
+
+type Failed struct {
+	ID     int
+	Reason string
+}
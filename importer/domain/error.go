@@ -0,0 +1,16 @@
+package domain
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrEmptyProvider   = Error("empty provider")
+	ErrUnknownProvider = Error("unknown provider")
+	ErrInvalidPage     = Error("invalid page")
+	ErrInvalidLimit    = Error("invalid limit")
+	ErrInvalidKind     = Error("invalid kind")
+	ErrInvalidData     = Error("invalid data")
+)
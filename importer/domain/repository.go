@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+)
+
+type MappingRepository interface {
+	FindMappings(context.Context, FindMappingsQuery) (FindMappingsResult, error)
+	FindIDsBySlugs(context.Context, FindIDsBySlugsQuery) (FindIDsBySlugsResult, error)
+}
+
+type FindMappingsQuery struct {
+	Provider string
+	Kind     Kind
+	Names    []string
+}
+
+type FindMappingsResult struct {
+	// IDs maps provider names to tag or category IDs.
+	IDs map[string]int
+}
+
+type FindIDsBySlugsQuery struct {
+	Kind  Kind
+	Slugs []string
+}
+
+type FindIDsBySlugsResult struct {
+	IDs map[string]int
+}
+
+type LinkRepository interface {
+	Link(context.Context, LinkQuery) error
+	FindLinks(context.Context, FindLinksQuery) (FindLinksResult, error)
+	FindUnlinked(context.Context) (FindUnlinkedResult, error)
+	MarkSynced(context.Context, MarkSyncedQuery) error
+}
+
+type LinkQuery struct {
+	GameID     int
+	Provider   string
+	ProviderID string
+}
+
+type FindLinksQuery struct {
+	Provider string
+}
+
+type FindLinksResult struct {
+	Data []Link
+}
+
+type FindUnlinkedResult struct {
+	Data []UnlinkedGame
+}
+
+// MarkSyncedQuery records a sync run. Present games are seen upstream,
+// missing ones keep the time they were first found missing.
+type MarkSyncedQuery struct {
+	Present []int
+	Missing []int
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+)
+
+type Service interface {
+	Import(context.Context, ImportRequest) (ImportResponse, error)
+	Sync(context.Context, SyncRequest) (SyncResponse, error)
+}
+
+type ImportRequest struct {
+	Provider string
+	// Page of the provider catalog to import from, Limit being its size.
+	Page  int
+	Limit int
+}
+
+func (r ImportRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Provider == "", ErrEmptyProvider)
+	err.AddIf(r.Page < 1, ErrInvalidPage)
+	err.AddIf(r.Limit < 1 || r.Limit > 100, ErrInvalidLimit)
+
+	return err.Err()
+}
+
+type ImportResponse struct {
+	Imported []Imported
+	Skipped  []Skipped
+}
+
+func (r ImportResponse) Validate() error {
+	var err zeroerror.Error
+
+	for i, g := range r.Imported {
+		if g.ID < 1 || g.Slug == "" {
+			err.Add(fmt.Errorf("%w: imported game at index %d", ErrInvalidData, i))
+		}
+	}
+
+	return err.Err()
+}
+
+type SyncRequest struct {
+	Provider string
+	// DryRun reports what would change without editing games.
+	DryRun bool
+}
+
+func (r SyncRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Provider == "", ErrEmptyProvider)
+
+	return err.Err()
+}
+
+type SyncResponse struct {
+	Provider string
+	// Listed is the number of games in the provider catalog.
+	Listed int
+	// Linked are games matched to the catalog by URL, they were imported
+	// before games were linked to their provider.
+	Linked  []SyncedGame
+	Updated []Updated
+	// Missing are games found missing from the catalog for the first time.
+	Missing []SyncedGame
+	// Removed are games still missing on the next run, they were set
+	// invisible.
+	Removed []SyncedGame
+	// Reappeared are games back in the catalog after being missing. They
+	// are left invisible for an editor to publish again.
+	Reappeared []SyncedGame
+	Failed     []Failed
+}
+
+func (r SyncResponse) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Provider == "", ErrEmptyProvider)
+	err.AddIf(r.Listed < 0, fmt.Errorf("%w: negative listed", ErrInvalidData))
+
+	return err.Err()
+}
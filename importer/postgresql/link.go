@@ -0,0 +1,133 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/vediagames/platform/importer/domain"
+)
+
+type linkRepository struct {
+	db *sqlx.DB
+}
+
+func NewLink(cfg Config) domain.LinkRepository {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &linkRepository{
+		db: cfg.DB,
+	}
+}
+
+func (r linkRepository) Link(ctx context.Context, q domain.LinkQuery) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO public.game_providers (game_id, provider, provider_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (game_id) DO UPDATE
+		SET provider = EXCLUDED.provider, provider_id = EXCLUDED.provider_id, missing_since = NULL
+	`, q.GameID, q.Provider, q.ProviderID)
+	if err != nil {
+		return fmt.Errorf("failed to exec: %w", err)
+	}
+
+	return nil
+}
+
+type link struct {
+	GameID       int         `db:"game_id"`
+	Provider     string      `db:"provider"`
+	ProviderID   string      `db:"provider_id"`
+	SyncedAt     pq.NullTime `db:"synced_at"`
+	MissingSince pq.NullTime `db:"missing_since"`
+}
+
+func (r linkRepository) FindLinks(ctx context.Context, q domain.FindLinksQuery) (domain.FindLinksResult, error) {
+	var rows []link
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT game_id, provider, provider_id, synced_at, missing_since
+		FROM public.game_providers
+		WHERE provider = $1
+		ORDER BY game_id
+	`, q.Provider)
+	if err != nil {
+		return domain.FindLinksResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindLinksResult{
+		Data: make([]domain.Link, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Data = append(res.Data, domain.Link{
+			GameID:       row.GameID,
+			Provider:     row.Provider,
+			ProviderID:   row.ProviderID,
+			SyncedAt:     row.SyncedAt.Time,
+			MissingSince: row.MissingSince.Time,
+		})
+	}
+
+	return res, nil
+}
+
+func (r linkRepository) FindUnlinked(ctx context.Context) (domain.FindUnlinkedResult, error) {
+	var rows []struct {
+		ID  int    `db:"id"`
+		URL string `db:"url"`
+	}
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT g.id, g.url
+		FROM public.games g
+		LEFT JOIN public.game_providers gp ON gp.game_id = g.id
+		WHERE gp.game_id IS NULL AND g.status != 'deleted'
+		ORDER BY g.id
+	`)
+	if err != nil {
+		return domain.FindUnlinkedResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindUnlinkedResult{
+		Data: make([]domain.UnlinkedGame, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Data = append(res.Data, domain.UnlinkedGame{
+			ID:  row.ID,
+			URL: row.URL,
+		})
+	}
+
+	return res, nil
+}
+
+func (r linkRepository) MarkSynced(ctx context.Context, q domain.MarkSyncedQuery) error {
+	now := time.Now()
+
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE public.game_providers
+		SET synced_at = $1, missing_since = NULL
+		WHERE game_id = ANY($2)
+	`, now, pq.Array(q.Present))
+	if err != nil {
+		return fmt.Errorf("failed to mark present: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		UPDATE public.game_providers
+		SET synced_at = $1, missing_since = COALESCE(missing_since, $1)
+		WHERE game_id = ANY($2)
+	`, now, pq.Array(q.Missing))
+	if err != nil {
+		return fmt.Errorf("failed to mark missing: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/importer/domain"
+)
+
+type mappingRepository struct {
+	db *sqlx.DB
+}
+
+type Config struct {
+	DB *sqlx.DB
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.DB == nil, fmt.Errorf("empty DB"))
+
+	if pingErr := c.DB.Ping(); pingErr != nil {
+		err.Add(fmt.Errorf("failed to ping: %w", pingErr))
+	}
+
+	return err.Err()
+}
+
+func NewMapping(cfg Config) domain.MappingRepository {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &mappingRepository{
+		db: cfg.DB,
+	}
+}
+
+type idRow struct {
+	Key string `db:"key"`
+	ID  int    `db:"id"`
+}
+
+func (r mappingRepository) FindMappings(ctx context.Context, q domain.FindMappingsQuery) (domain.FindMappingsResult, error) {
+	var rows []idRow
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT external AS key, internal_id AS id
+		FROM public.provider_mappings
+		WHERE provider = $1 AND kind = $2 AND external = ANY($3)
+	`, q.Provider, q.Kind.String(), pq.Array(q.Names))
+	if err != nil {
+		return domain.FindMappingsResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	return domain.FindMappingsResult{
+		IDs: toIDs(rows),
+	}, nil
+}
+
+var kindTables = map[domain.Kind]string{
+	domain.KindTag:      "public.tags",
+	domain.KindCategory: "public.categories",
+}
+
+func (r mappingRepository) FindIDsBySlugs(ctx context.Context, q domain.FindIDsBySlugsQuery) (domain.FindIDsBySlugsResult, error) {
+	table, ok := kindTables[q.Kind]
+	if !ok {
+		return domain.FindIDsBySlugsResult{}, fmt.Errorf("unsupported kind: %q", q.Kind)
+	}
+
+	var rows []idRow
+
+	err := r.db.SelectContext(ctx, &rows, fmt.Sprintf(`
+		SELECT slug AS key, id
+		FROM %s
+		WHERE slug = ANY($1) AND deleted_at IS NULL
+	`, table), pq.Array(q.Slugs))
+	if err != nil {
+		return domain.FindIDsBySlugsResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	return domain.FindIDsBySlugsResult{
+		IDs: toIDs(rows),
+	}, nil
+}
+
+func toIDs(rows []idRow) map[string]int {
+	ids := make(map[string]int, len(rows))
+	for _, row := range rows {
+		ids[row.Key] = row.ID
+	}
+
+	return ids
+}
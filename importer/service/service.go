@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gosimple/slug"
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	bucketdomain "github.com/vediagames/platform/bucket/domain"
+	fetcherdomain "github.com/vediagames/platform/fetcher/domain"
+	gamedomain "github.com/vediagames/platform/game/domain"
+	imagedomain "github.com/vediagames/platform/image/domain"
+	"github.com/vediagames/platform/importer/domain"
+)
+
+type Config struct {
+	Providers         map[string]fetcherdomain.Client
+	GameService       gamedomain.Service
+	MappingRepository domain.MappingRepository
+	LinkRepository    domain.LinkRepository
+	BucketClient      bucketdomain.Client
+	HTTPClient        *http.Client
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(len(c.Providers) == 0, fmt.Errorf("no providers registered"))
+	err.AddIf(c.GameService == nil, fmt.Errorf("empty game service"))
+	err.AddIf(c.MappingRepository == nil, fmt.Errorf("empty mapping repository"))
+	err.AddIf(c.LinkRepository == nil, fmt.Errorf("empty link repository"))
+	err.AddIf(c.BucketClient == nil, fmt.Errorf("empty bucket client"))
+	err.AddIf(c.HTTPClient == nil, fmt.Errorf("empty HTTP client"))
+
+	return err.Err()
+}
+
+type service struct {
+	providers         map[string]fetcherdomain.Client
+	gameService       gamedomain.Service
+	mappingRepository domain.MappingRepository
+	linkRepository    domain.LinkRepository
+	bucketClient      bucketdomain.Client
+	httpClient        *http.Client
+}
+
+func New(cfg Config) domain.Service {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &service{
+		providers:         cfg.Providers,
+		gameService:       cfg.GameService,
+		mappingRepository: cfg.MappingRepository,
+		linkRepository:    cfg.LinkRepository,
+		bucketClient:      cfg.BucketClient,
+		httpClient:        cfg.HTTPClient,
+	}
+}
+
+func (s service) Import(ctx context.Context, req domain.ImportRequest) (domain.ImportResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.ImportResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	client, ok := s.providers[req.Provider]
+	if !ok {
+		return domain.ImportResponse{}, fmt.Errorf("invalid request: %w: %q", domain.ErrUnknownProvider, req.Provider)
+	}
+
+	listRes, err := client.List(ctx, fetcherdomain.ListRequest{
+		Page:  req.Page,
+		Limit: req.Limit,
+	})
+	if err != nil {
+		return domain.ImportResponse{}, fmt.Errorf("failed to list: %w", err)
+	}
+
+	var res domain.ImportResponse
+
+	for _, g := range listRes.Data {
+		imported, err := s.importGame(ctx, req.Provider, g)
+		if err != nil {
+			res.Skipped = append(res.Skipped, domain.Skipped{
+				Slug:   g.Slug,
+				Reason: err.Error(),
+			})
+
+			continue
+		}
+
+		res.Imported = append(res.Imported, imported)
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.ImportResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) importGame(ctx context.Context, provider string, g fetcherdomain.FetchedGame) (domain.Imported, error) {
+	if g.Slug == "" {
+		return domain.Imported{}, fmt.Errorf("empty slug")
+	}
+
+	getRes, err := s.gameService.Get(ctx, gamedomain.GetRequest{
+		Field:    gamedomain.GetByFieldSlug,
+		Value:    g.Slug,
+		Language: gamedomain.LanguageEnglish,
+	})
+	// A redirect means the slug is an old slug of another game, the new game
+	// may take it over.
+	switch {
+	case err == nil && getRes.RedirectTo == "":
+		return domain.Imported{}, fmt.Errorf("slug already exists")
+	case err != nil && !errors.Is(err, gamedomain.ErrNoData):
+		return domain.Imported{}, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	tagIDs, err := s.resolve(ctx, provider, domain.KindTag, g.Tags)
+	if err != nil {
+		return domain.Imported{}, fmt.Errorf("failed to resolve tags: %w", err)
+	}
+
+	categoryIDs, err := s.resolve(ctx, provider, domain.KindCategory, g.Categories)
+	if err != nil {
+		return domain.Imported{}, fmt.Errorf("failed to resolve categories: %w", err)
+	}
+
+	createReq := createRequest(g, tagIDs, categoryIDs)
+	if err := createReq.Validate(); err != nil {
+		return domain.Imported{}, fmt.Errorf("invalid game: %w", err)
+	}
+
+	createRes, err := s.gameService.Create(ctx, createReq)
+	if err != nil {
+		return domain.Imported{}, fmt.Errorf("failed to create game: %w", err)
+	}
+
+	// Images are only uploaded for a created game, a failed import leaves no
+	// objects behind under its slug.
+	images := s.uploadImages(ctx, createRes.Data.Slug, g.Images)
+
+	// The game exists at this point, so a failed link is only logged. The
+	// sync job links it by URL on its next run.
+	if err := s.linkRepository.Link(ctx, domain.LinkQuery{
+		GameID:     createRes.Data.ID,
+		Provider:   provider,
+		ProviderID: g.ProviderID,
+	}); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("game_id", createRes.Data.ID).Msg("failed to link game to provider")
+	}
+
+	return domain.Imported{
+		ID:     createRes.Data.ID,
+		Slug:   createRes.Data.Slug,
+		Images: images,
+	}, nil
+}
+
+// author is recorded on the game revisions the importer makes.
+const author = "importer"
+
+func createRequest(g fetcherdomain.FetchedGame, tagIDs, categoryIDs []int) gamedomain.CreateRequest {
+	return gamedomain.CreateRequest{
+		Slug:           g.Slug,
+		Mobile:         g.Mobile,
+		TagIDRefs:      tagIDs,
+		CategoryIDRefs: categoryIDs,
+		Status:         gamedomain.StatusInvisible,
+		URL:            g.URL,
+		Width:          g.Width,
+		Height:         g.Height,
+		Texts: map[gamedomain.Language]gamedomain.Texts{
+			gamedomain.LanguageEnglish: {
+				Name:             g.Name,
+				ShortDescription: shortDescription(g.Description),
+				Description:      g.Description,
+				Player1Controls:  g.Controls,
+			},
+		},
+		Author: author,
+	}
+}
+
+const maxShortDescriptionLength = 160
+
+// shortDescription returns the first sentence of description when it is short
+// enough, otherwise description cut at a word boundary. The length is counted
+// in characters, a cut never splits one.
+func shortDescription(description string) string {
+	if i := strings.Index(description, ". "); i > 0 && utf8.RuneCountInString(description[:i]) < maxShortDescriptionLength {
+		return description[:i+1]
+	}
+
+	runes := []rune(description)
+	if len(runes) <= maxShortDescriptionLength {
+		return description
+	}
+
+	head := string(runes[:maxShortDescriptionLength])
+	if cut := strings.LastIndex(head, " "); cut > 0 {
+		head = head[:cut]
+	}
+
+	return strings.TrimSpace(head) + "..."
+}
+
+// resolve maps provider names through provider_mappings first and falls back
+// to tags or categories whose slug is the slugified name. Names without a
+// match are dropped.
+func (s service) resolve(ctx context.Context, provider string, kind domain.Kind, names []string) ([]int, error) {
+	names = compact(names)
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	mapped, err := s.mappingRepository.FindMappings(ctx, domain.FindMappingsQuery{
+		Provider: provider,
+		Kind:     kind,
+		Names:    names,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find mappings: %w", err)
+	}
+
+	var slugs []string
+	for _, name := range names {
+		if _, ok := mapped.IDs[name]; !ok {
+			slugs = append(slugs, slug.Make(name))
+		}
+	}
+
+	bySlug := domain.FindIDsBySlugsResult{}
+	if len(slugs) > 0 {
+		bySlug, err = s.mappingRepository.FindIDsBySlugs(ctx, domain.FindIDsBySlugsQuery{
+			Kind:  kind,
+			Slugs: slugs,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find IDs by slugs: %w", err)
+		}
+	}
+
+	var (
+		ids  []int
+		seen = make(map[int]bool)
+	)
+
+	for _, name := range names {
+		id, ok := mapped.IDs[name]
+		if !ok {
+			id, ok = bySlug.IDs[slug.Make(name)]
+		}
+
+		if !ok || seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func compact(names []string) []string {
+	res := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			res = append(res, name)
+		}
+	}
+
+	return res
+}
+
+// uploadImages stores provider images under games/<slug>/ with the file
+// names image/service resolves originals from, e.g. thumb512x384.jpg. It
+// returns how many were uploaded, failures are logged and skipped.
+func (s service) uploadImages(ctx context.Context, slug string, urls []string) int {
+	uploaded := 0
+
+	for _, u := range urls {
+		img, err := imageFromURL(u)
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Str("url", u).Msg("skipping image")
+			continue
+		}
+
+		if err := s.uploadImage(ctx, fmt.Sprintf("games/%s/%s", slug, img.File()), u); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Str("url", u).Msg("failed to upload image")
+			continue
+		}
+
+		uploaded++
+	}
+
+	return uploaded
+}
+
+func (s service) uploadImage(ctx context.Context, dst, src string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+
+	if err := s.bucketClient.Upload(ctx, dst, res.Body); err != nil {
+		return fmt.Errorf("failed to upload: %w", err)
+	}
+
+	return nil
+}
+
+var imageSizeRegexp = regexp.MustCompile(`(\d+)x(\d+)`)
+
+func imageFromURL(rawURL string) (imagedomain.Image, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return imagedomain.Image{}, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	base := path.Base(u.Path)
+
+	match := imageSizeRegexp.FindStringSubmatch(base)
+	if match == nil {
+		return imagedomain.Image{}, fmt.Errorf("no size in file name %q", base)
+	}
+
+	width, _ := strconv.Atoi(match[1])
+	height, _ := strconv.Atoi(match[2])
+
+	format := imagedomain.Format(strings.ToLower(strings.TrimPrefix(path.Ext(base), ".")))
+	if format == "jpeg" {
+		format = imagedomain.FormatJpg
+	}
+
+	img := imagedomain.Image{
+		Format: format,
+		Width:  width,
+		Height: height,
+	}
+
+	if err := img.Validate(); err != nil {
+		return imagedomain.Image{}, fmt.Errorf("invalid image: %w", err)
+	}
+
+	return img, nil
+}
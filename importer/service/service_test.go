@@ -0,0 +1,100 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	imagedomain "github.com/vediagames/platform/image/domain"
+)
+
+func Test_imageFromURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "gamedistribution",
+			url:  "https://img.gamedistribution.com/0f5c5f9f3b0b4c1a9d9f8a3f7c2a1b6e-512x384.jpeg",
+			want: "thumb512x384.jpg",
+		},
+		{
+			name: "gamemonetize",
+			url:  "https://img.gamemonetize.com/abc123/512x512.jpg",
+			want: "thumb512x512.jpg",
+		},
+		{
+			name:    "no size",
+			url:     "https://img.gamemonetize.com/abc123/thumb.jpg",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported format",
+			url:     "https://img.gamedistribution.com/abc-512x384.gif",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := imageFromURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("imageFromURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.File() != tt.want {
+				t.Errorf("imageFromURL() = %q, want %q", got.File(), tt.want)
+			}
+
+			if err != nil && got != (imagedomain.Image{}) {
+				t.Errorf("imageFromURL() = %v, want empty image on error", got)
+			}
+		})
+	}
+}
+
+func Test_shortDescription(t *testing.T) {
+	long := strings.Repeat("ñandú ", 40)
+
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{
+			name:        "first sentence",
+			description: "Jump over walls. Collect every coin.",
+			want:        "Jump over walls.",
+		},
+		{
+			name:        "short",
+			description: "Jump over walls",
+			want:        "Jump over walls",
+		},
+		{
+			name:        "cut at word in multibyte text",
+			description: long,
+			want:        strings.TrimSpace(strings.Repeat("ñandú ", 26)) + "...",
+		},
+		{
+			name:        "cut without space in multibyte text",
+			description: strings.Repeat("ñ", 200),
+			want:        strings.Repeat("ñ", 160) + "...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shortDescription(tt.description)
+			if got != tt.want {
+				t.Errorf("shortDescription() = %q, want %q", got, tt.want)
+			}
+
+			if !utf8.ValidString(got) {
+				t.Errorf("shortDescription() = %q, want valid UTF-8", got)
+			}
+		})
+	}
+}
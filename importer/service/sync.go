@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	fetcherdomain "github.com/vediagames/platform/fetcher/domain"
+	gamedomain "github.com/vediagames/platform/game/domain"
+	"github.com/vediagames/platform/importer/domain"
+)
+
+const (
+	syncPageSize = 100
+	// syncMaxPages bounds the catalog walk, a catalog that does not end
+	// within it is treated as incomplete and nothing is removed.
+	syncMaxPages = 1000
+)
+
+// Sync compares linked games with the provider catalog. Changed URLs and
+// dimensions are applied through the game service. A game missing from the
+// catalog is only flagged on the first run and set invisible when it is still
+// missing on the next one, so a catalog that shifts during the walk does not
+// hide games.
+func (s service) Sync(ctx context.Context, req domain.SyncRequest) (domain.SyncResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.SyncResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	client, ok := s.providers[req.Provider]
+	if !ok {
+		return domain.SyncResponse{}, fmt.Errorf("invalid request: %w: %q", domain.ErrUnknownProvider, req.Provider)
+	}
+
+	catalog, err := listCatalog(ctx, client)
+	if err != nil {
+		return domain.SyncResponse{}, fmt.Errorf("failed to list catalog: %w", err)
+	}
+
+	linksRes, err := s.linkRepository.FindLinks(ctx, domain.FindLinksQuery{
+		Provider: req.Provider,
+	})
+	if err != nil {
+		return domain.SyncResponse{}, fmt.Errorf("failed to find links: %w", err)
+	}
+
+	res := domain.SyncResponse{
+		Provider: req.Provider,
+		Listed:   len(catalog.games),
+	}
+
+	linked, err := s.linkByURL(ctx, req, catalog, &res)
+	if err != nil {
+		return domain.SyncResponse{}, fmt.Errorf("failed to link by URL: %w", err)
+	}
+
+	var marks domain.MarkSyncedQuery
+
+	for _, link := range append(linksRes.Data, linked...) {
+		gameRes, err := s.gameService.Get(ctx, gamedomain.GetRequest{
+			Field:    gamedomain.GetByFieldID,
+			Value:    link.GameID,
+			Language: gamedomain.LanguageEnglish,
+		})
+		if err != nil {
+			res.Failed = append(res.Failed, domain.Failed{
+				ID:     link.GameID,
+				Reason: fmt.Sprintf("failed to get game: %s", err),
+			})
+
+			continue
+		}
+
+		game := gameRes.Data
+		if game.Status == gamedomain.StatusDeleted {
+			continue
+		}
+
+		synced := domain.SyncedGame{
+			ID:   game.ID,
+			Slug: game.Slug,
+		}
+
+		fetched, ok := catalog.games[link.ProviderID]
+		if !ok {
+			marks.Missing = append(marks.Missing, game.ID)
+
+			if link.MissingSince.IsZero() {
+				res.Missing = append(res.Missing, synced)
+				continue
+			}
+
+			if game.Status == gamedomain.StatusInvisible {
+				continue
+			}
+
+			if err := s.edit(ctx, req.DryRun, game, func(r *gamedomain.EditRequest) {
+				r.Status = gamedomain.StatusInvisible
+			}); err != nil {
+				res.Failed = append(res.Failed, domain.Failed{
+					ID:     game.ID,
+					Reason: err.Error(),
+				})
+
+				continue
+			}
+
+			res.Removed = append(res.Removed, synced)
+
+			continue
+		}
+
+		marks.Present = append(marks.Present, game.ID)
+
+		if !link.MissingSince.IsZero() {
+			res.Reappeared = append(res.Reappeared, synced)
+		}
+
+		changes, apply := diff(game, fetched, catalog.mobile)
+		if len(changes) == 0 {
+			continue
+		}
+
+		if err := s.edit(ctx, req.DryRun, game, apply); err != nil {
+			res.Failed = append(res.Failed, domain.Failed{
+				ID:     game.ID,
+				Reason: err.Error(),
+			})
+
+			continue
+		}
+
+		res.Updated = append(res.Updated, domain.Updated{
+			ID:      game.ID,
+			Slug:    game.Slug,
+			Changes: changes,
+		})
+	}
+
+	if !req.DryRun {
+		if err := s.linkRepository.MarkSynced(ctx, marks); err != nil {
+			return domain.SyncResponse{}, fmt.Errorf("failed to mark synced: %w", err)
+		}
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.SyncResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+type catalog struct {
+	games  map[string]fetcherdomain.FetchedGame
+	byURL  map[string]fetcherdomain.FetchedGame
+	mobile bool
+}
+
+func listCatalog(ctx context.Context, client fetcherdomain.Client) (catalog, error) {
+	c := catalog{
+		games: make(map[string]fetcherdomain.FetchedGame),
+		byURL: make(map[string]fetcherdomain.FetchedGame),
+	}
+
+	for page := 1; page <= syncMaxPages; page++ {
+		listRes, err := client.List(ctx, fetcherdomain.ListRequest{
+			Page:  page,
+			Limit: syncPageSize,
+		})
+		if err != nil {
+			return catalog{}, fmt.Errorf("failed to list page %d: %w", page, err)
+		}
+
+		for _, g := range listRes.Data {
+			if g.ProviderID == "" {
+				continue
+			}
+
+			c.games[g.ProviderID] = g
+			c.byURL[baseURL(g.URL)] = g
+			c.mobile = c.mobile || g.Mobile
+		}
+
+		if len(listRes.Data) < syncPageSize {
+			if len(c.games) == 0 {
+				return catalog{}, fmt.Errorf("empty catalog")
+			}
+
+			return c, nil
+		}
+	}
+
+	return catalog{}, fmt.Errorf("catalog has more than %d pages", syncMaxPages)
+}
+
+// linkByURL links games imported before games were linked to their provider.
+func (s service) linkByURL(ctx context.Context, req domain.SyncRequest, c catalog, res *domain.SyncResponse) ([]domain.Link, error) {
+	unlinkedRes, err := s.linkRepository.FindUnlinked(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unlinked: %w", err)
+	}
+
+	var links []domain.Link
+
+	for _, g := range unlinkedRes.Data {
+		fetched, ok := c.byURL[baseURL(g.URL)]
+		if !ok {
+			continue
+		}
+
+		link := domain.Link{
+			GameID:     g.ID,
+			Provider:   req.Provider,
+			ProviderID: fetched.ProviderID,
+		}
+
+		if !req.DryRun {
+			if err := s.linkRepository.Link(ctx, domain.LinkQuery{
+				GameID:     link.GameID,
+				Provider:   link.Provider,
+				ProviderID: link.ProviderID,
+			}); err != nil {
+				res.Failed = append(res.Failed, domain.Failed{
+					ID:     g.ID,
+					Reason: fmt.Sprintf("failed to link: %s", err),
+				})
+
+				continue
+			}
+		}
+
+		links = append(links, link)
+		res.Linked = append(res.Linked, domain.SyncedGame{
+			ID:   g.ID,
+			Slug: fetched.Slug,
+		})
+	}
+
+	return links, nil
+}
+
+// diff returns the changes between a game and its catalog entry, and a
+// function applying them to an edit request. Only values the game service
+// accepts are taken over. Mobile is left alone for providers that flag no
+// game as mobile, they do not report it at all.
+func diff(game gamedomain.Game, fetched fetcherdomain.FetchedGame, reportsMobile bool) ([]domain.Change, func(*gamedomain.EditRequest)) {
+	var (
+		changes []domain.Change
+		edits   []func(*gamedomain.EditRequest)
+	)
+
+	if validURL(fetched.URL) && baseURL(fetched.URL) != baseURL(game.URL) {
+		changes = append(changes, domain.Change{Field: "url", Old: game.URL, New: fetched.URL})
+		edits = append(edits, func(r *gamedomain.EditRequest) { r.URL = fetched.URL })
+	}
+
+	if fetched.Width > 0 && fetched.Width != game.Width {
+		changes = append(changes, domain.Change{Field: "width", Old: strconv.Itoa(game.Width), New: strconv.Itoa(fetched.Width)})
+		edits = append(edits, func(r *gamedomain.EditRequest) { r.Width = fetched.Width })
+	}
+
+	if fetched.Height > 0 && fetched.Height != game.Height {
+		changes = append(changes, domain.Change{Field: "height", Old: strconv.Itoa(game.Height), New: strconv.Itoa(fetched.Height)})
+		edits = append(edits, func(r *gamedomain.EditRequest) { r.Height = fetched.Height })
+	}
+
+	if reportsMobile && fetched.Mobile != game.Mobile {
+		changes = append(changes, domain.Change{Field: "mobile", Old: strconv.FormatBool(game.Mobile), New: strconv.FormatBool(fetched.Mobile)})
+		edits = append(edits, func(r *gamedomain.EditRequest) { r.Mobile = fetched.Mobile })
+	}
+
+	return changes, func(r *gamedomain.EditRequest) {
+		for _, edit := range edits {
+			edit(r)
+		}
+	}
+}
+
+// edit applies apply to an edit request of the game as it is. Texts are left
+// out, the repository only updates the languages it is given. Counters are
+// left out too, the game may carry pending deltas that are not flushed yet.
+func (s service) edit(ctx context.Context, dryRun bool, game gamedomain.Game, apply func(*gamedomain.EditRequest)) error {
+	req := gamedomain.EditRequest{
+		ID:             game.ID,
+		Slug:           game.Slug,
+		Mobile:         game.Mobile,
+		TagIDRefs:      game.TagIDRefs,
+		CategoryIDRefs: game.CategoryIDRefs,
+		Status:         game.Status,
+		URL:            game.URL,
+		Width:          game.Width,
+		Height:         game.Height,
+		Weight:         game.Weight,
+		Author:         author,
+	}
+
+	apply(&req)
+
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("invalid edit: %w", err)
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	if _, err := s.gameService.Edit(ctx, req); err != nil {
+		return fmt.Errorf("failed to edit game: %w", err)
+	}
+
+	return nil
+}
+
+func validURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// baseURL drops the query, it carries referrer parameters that are not part
+// of the game URL.
+func baseURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u.String()
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	fetcherdomain "github.com/vediagames/platform/fetcher/domain"
+	gamedomain "github.com/vediagames/platform/game/domain"
+)
+
+func Test_diff(t *testing.T) {
+	game := gamedomain.Game{
+		URL:    "https://html5.gamedistribution.com/abc/?gd_sdk_referrer_url=https://vedia.games/game/abc",
+		Width:  800,
+		Height: 600,
+		Mobile: true,
+	}
+
+	tests := []struct {
+		name          string
+		fetched       fetcherdomain.FetchedGame
+		reportsMobile bool
+		wantFields    []string
+	}{
+		{
+			name: "referrer only",
+			fetched: fetcherdomain.FetchedGame{
+				URL:    "https://html5.gamedistribution.com/abc/?gd_sdk_referrer_url=https://vedia.games/game/renamed",
+				Width:  800,
+				Height: 600,
+				Mobile: true,
+			},
+			reportsMobile: true,
+		},
+		{
+			name: "url and dimensions",
+			fetched: fetcherdomain.FetchedGame{
+				URL:    "https://html5.gamedistribution.com/def/",
+				Width:  1280,
+				Height: 720,
+				Mobile: true,
+			},
+			reportsMobile: true,
+			wantFields:    []string{"url", "width", "height"},
+		},
+		{
+			name: "invalid values are ignored",
+			fetched: fetcherdomain.FetchedGame{
+				URL: "not a url",
+			},
+		},
+		{
+			name: "mobile",
+			fetched: fetcherdomain.FetchedGame{
+				URL:    game.URL,
+				Width:  800,
+				Height: 600,
+			},
+			reportsMobile: true,
+			wantFields:    []string{"mobile"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, apply := diff(game, tt.fetched, tt.reportsMobile)
+
+			var fields []string
+			for _, c := range changes {
+				fields = append(fields, c.Field)
+			}
+
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Fatalf("diff() fields = %v, want %v", fields, tt.wantFields)
+			}
+
+			req := gamedomain.EditRequest{URL: game.URL, Width: game.Width, Height: game.Height, Mobile: game.Mobile}
+			apply(&req)
+
+			applied := gamedomain.Game{URL: req.URL, Width: req.Width, Height: req.Height, Mobile: req.Mobile}
+			if left, _ := diff(applied, tt.fetched, tt.reportsMobile); len(left) > 0 {
+				t.Errorf("diff() after apply = %+v, want no changes", left)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vediagames/zeroerror"
+)
+
+type Lists struct {
+	Data  []List
+	Total int
+}
+
+func (l Lists) Validate() error {
+	var err zeroerror.Error
+
+	for _, list := range l.Data {
+		if ve := list.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w: %w", ErrInvalidList, ve))
+		}
+	}
+
+	if l.Total < 0 {
+		err.Add(ErrInvalidTotal)
+	}
+
+	return err.Err()
+}
+
+type List struct {
+	Slug        string
+	Name        string
+	Description string
+	Games       Games
+}
+
+func (l List) Validate() error {
+	var err zeroerror.Error
+
+	if l.Slug == "" {
+		err.Add(ErrEmptySlug)
+	}
+
+	if l.Name == "" {
+		err.Add(ErrEmptyName)
+	}
+
+	if ve := l.Games.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidGames, ve))
+	}
+
+	return err.Err()
+}
+
+type Games []Game
+
+func (g Games) Validate() error {
+	var err zeroerror.Error
+
+	for i, game := range g {
+		if ve := game.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at index %d: %w", ErrInvalidGame, i, ve))
+		}
+	}
+
+	return err.Err()
+}
+
+func (g Games) Slugs() []string {
+	slugs := make([]string, 0, len(g))
+	for _, game := range g {
+		slugs = append(slugs, game.Slug)
+	}
+	return slugs
+}
+
+func (g Games) FindBySlug(slug string) (Game, bool) {
+	for _, game := range g {
+		if game.Slug == slug {
+			return game, true
+		}
+	}
+
+	return Game{}, false
+}
+
+type Game struct {
+	Slug        string
+	Label       string
+	Description string
+	Position    int
+	InsertedAt  time.Time
+}
+
+func (g Game) Validate() error {
+	var err zeroerror.Error
+
+	if g.Slug == "" {
+		err.Add(ErrEmptySlug)
+	}
+
+	if g.Position < 0 {
+		err.Add(ErrInvalidPosition)
+	}
+
+	if g.InsertedAt.IsZero() {
+		err.Add(ErrInvalidInsertedAt)
+	}
+
+	return err.Err()
+}
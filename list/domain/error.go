@@ -0,0 +1,27 @@
+package domain
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrInvalidPage       = Error("invalid page")
+	ErrInvalidLimit      = Error("invalid limit")
+	ErrEmptySlug         = Error("empty slug")
+	ErrEmptyListSlug     = Error("empty list slug")
+	ErrEmptyGameSlug     = Error("empty game slug")
+	ErrEmptyName         = Error("empty name")
+	ErrInvalidTotal      = Error("invalid total")
+	ErrInvalidList       = Error("invalid list")
+	ErrInvalidGame       = Error("invalid game")
+	ErrInvalidGames      = Error("invalid games")
+	ErrInvalidPosition   = Error("invalid position")
+	ErrInvalidInsertedAt = Error("invalid inserted at")
+	ErrInvalidData       = Error("invalid data")
+	ErrDuplicateGameSlug = Error("duplicate game slug")
+	ErrNoData            = Error("no data")
+	ErrGameNotInList     = Error("game not in list")
+	ErrGameAlreadyInList = Error("game already in list")
+)
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Find(context.Context, FindQuery) (FindResult, error)
+	FindOne(context.Context, FindOneQuery) (FindOneResult, error)
+	InsertGame(context.Context, InsertGameQuery) error
+	DeleteGame(context.Context, DeleteGameQuery) error
+	UpdatePositions(context.Context, UpdatePositionsQuery) error
+}
+
+type FindQuery struct {
+	Page  int
+	Limit int
+}
+
+type FindResult struct {
+	Data Lists
+}
+
+type FindOneQuery struct {
+	Slug string
+}
+
+type FindOneResult struct {
+	Data List
+}
+
+type InsertGameQuery struct {
+	ListSlug    string
+	GameSlug    string
+	Label       string
+	Description string
+}
+
+type DeleteGameQuery struct {
+	ListSlug string
+	GameSlug string
+}
+
+type UpdatePositionsQuery struct {
+	ListSlug  string
+	GameSlugs []string
+}
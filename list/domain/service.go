@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+)
+
+type Service interface {
+	List(context.Context, ListRequest) (ListResponse, error)
+	Get(context.Context, GetRequest) (GetResponse, error)
+	AddGame(context.Context, AddGameRequest) (AddGameResponse, error)
+	RemoveGame(context.Context, RemoveGameRequest) (RemoveGameResponse, error)
+	Reorder(context.Context, ReorderRequest) (ReorderResponse, error)
+}
+
+type ListRequest struct {
+	Page  int
+	Limit int
+}
+
+func (r ListRequest) Validate() error {
+	var err zeroerror.Error
+
+	if r.Page < 1 {
+		err.Add(ErrInvalidPage)
+	}
+
+	if r.Limit < 1 {
+		err.Add(ErrInvalidLimit)
+	}
+
+	return err.Err()
+}
+
+type ListResponse struct {
+	Data Lists
+}
+
+func (r ListResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+type GetRequest struct {
+	Slug string
+}
+
+func (r GetRequest) Validate() error {
+	var err zeroerror.Error
+
+	if r.Slug == "" {
+		err.Add(ErrEmptySlug)
+	}
+
+	return err.Err()
+}
+
+type GetResponse struct {
+	Data List
+}
+
+func (r GetResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+type AddGameRequest struct {
+	ListSlug    string
+	GameSlug    string
+	Label       string
+	Description string
+}
+
+func (r AddGameRequest) Validate() error {
+	var err zeroerror.Error
+
+	if r.ListSlug == "" {
+		err.Add(ErrEmptyListSlug)
+	}
+
+	if r.GameSlug == "" {
+		err.Add(ErrEmptyGameSlug)
+	}
+
+	return err.Err()
+}
+
+type AddGameResponse struct {
+	Data List
+}
+
+func (r AddGameResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+type RemoveGameRequest struct {
+	ListSlug string
+	GameSlug string
+}
+
+func (r RemoveGameRequest) Validate() error {
+	var err zeroerror.Error
+
+	if r.ListSlug == "" {
+		err.Add(ErrEmptyListSlug)
+	}
+
+	if r.GameSlug == "" {
+		err.Add(ErrEmptyGameSlug)
+	}
+
+	return err.Err()
+}
+
+type RemoveGameResponse struct {
+	Data List
+}
+
+func (r RemoveGameResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+// ReorderRequest sets the order of games in a list. GameSlugs must contain
+// every game of the list exactly once, first slug ends up on top.
+type ReorderRequest struct {
+	ListSlug  string
+	GameSlugs []string
+}
+
+func (r ReorderRequest) Validate() error {
+	var err zeroerror.Error
+
+	if r.ListSlug == "" {
+		err.Add(ErrEmptyListSlug)
+	}
+
+	seen := make(map[string]struct{}, len(r.GameSlugs))
+
+	for i, slug := range r.GameSlugs {
+		if slug == "" {
+			err.Add(fmt.Errorf("%w at index %d", ErrEmptyGameSlug, i))
+			continue
+		}
+
+		if _, ok := seen[slug]; ok {
+			err.Add(fmt.Errorf("%w: %q", ErrDuplicateGameSlug, slug))
+		}
+
+		seen[slug] = struct{}{}
+	}
+
+	return err.Err()
+}
+
+type ReorderResponse struct {
+	Data List
+}
+
+func (r ReorderResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
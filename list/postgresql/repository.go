@@ -0,0 +1,266 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/list/domain"
+)
+
+type repository struct {
+	db *sqlx.DB
+}
+
+type Config struct {
+	DB *sqlx.DB
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.DB == nil, fmt.Errorf("empty DB"))
+
+	if pingErr := c.DB.Ping(); pingErr != nil {
+		err.Add(fmt.Errorf("failed to ping: %w", pingErr))
+	}
+
+	return err.Err()
+}
+
+func New(cfg Config) domain.Repository {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &repository{
+		db: cfg.DB,
+	}
+}
+
+type list struct {
+	Slug        string         `db:"slug"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+}
+
+func (l list) toDomain(games []listGame) domain.List {
+	res := domain.List{
+		Slug:        l.Slug,
+		Name:        l.Name,
+		Description: l.Description.String,
+		Games:       make(domain.Games, 0, len(games)),
+	}
+
+	for _, game := range games {
+		if game.ListSlug != l.Slug {
+			continue
+		}
+
+		res.Games = append(res.Games, game.toDomain())
+	}
+
+	return res
+}
+
+type listGame struct {
+	ListSlug    string         `db:"list_slug"`
+	GameSlug    string         `db:"game_slug"`
+	Label       sql.NullString `db:"label"`
+	Description sql.NullString `db:"description"`
+	Position    int            `db:"position"`
+	InsertedAt  time.Time      `db:"inserted_at"`
+}
+
+func (g listGame) toDomain() domain.Game {
+	return domain.Game{
+		Slug:        g.GameSlug,
+		Label:       g.Label.String,
+		Description: g.Description.String,
+		Position:    g.Position,
+		InsertedAt:  g.InsertedAt,
+	}
+}
+
+func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindResult, error) {
+	var sqlRes []struct {
+		list
+		TotalCount int `db:"total_count"`
+	}
+
+	offset := (q.Page - 1) * q.Limit
+
+	err := r.db.SelectContext(ctx, &sqlRes, `
+		SELECT
+			slug,
+			name,
+			description,
+			COUNT(*) OVER() AS total_count
+		FROM public.lists
+		ORDER BY slug ASC
+		LIMIT $1 OFFSET $2
+	`, q.Limit, offset)
+	if err != nil {
+		return domain.FindResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindResult{
+		Data: domain.Lists{
+			Data:  make([]domain.List, 0, len(sqlRes)),
+			Total: 0,
+		},
+	}
+
+	if len(sqlRes) == 0 {
+		return res, nil
+	}
+
+	slugs := make([]string, 0, len(sqlRes))
+	for _, l := range sqlRes {
+		slugs = append(slugs, l.Slug)
+	}
+
+	games, err := r.findGames(ctx, slugs...)
+	if err != nil {
+		return domain.FindResult{}, fmt.Errorf("failed to find games: %w", err)
+	}
+
+	res.Data.Total = sqlRes[0].TotalCount
+
+	for _, l := range sqlRes {
+		res.Data.Data = append(res.Data.Data, l.toDomain(games))
+	}
+
+	return res, nil
+}
+
+func (r repository) FindOne(ctx context.Context, q domain.FindOneQuery) (domain.FindOneResult, error) {
+	var sqlRes list
+
+	err := r.db.GetContext(ctx, &sqlRes, `
+		SELECT
+			slug,
+			name,
+			description
+		FROM public.lists
+		WHERE slug = $1
+	`, q.Slug)
+	switch {
+	case err == sql.ErrNoRows:
+		return domain.FindOneResult{}, domain.ErrNoData
+	case err != nil:
+		return domain.FindOneResult{}, fmt.Errorf("failed to get: %w", err)
+	}
+
+	games, err := r.findGames(ctx, q.Slug)
+	if err != nil {
+		return domain.FindOneResult{}, fmt.Errorf("failed to find games: %w", err)
+	}
+
+	return domain.FindOneResult{
+		Data: sqlRes.toDomain(games),
+	}, nil
+}
+
+func (r repository) findGames(ctx context.Context, listSlugs ...string) ([]listGame, error) {
+	var sqlRes []listGame
+
+	sqlQuery, args, err := sqlx.In(`
+		SELECT
+			list_slug,
+			game_slug,
+			label,
+			description,
+			position,
+			inserted_at
+		FROM public.list_games
+		WHERE list_slug IN (?)
+		ORDER BY list_slug ASC, position ASC, inserted_at ASC
+	`, listSlugs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create query: %w", err)
+	}
+
+	if err := r.db.SelectContext(ctx, &sqlRes, r.db.Rebind(sqlQuery), args...); err != nil {
+		return nil, fmt.Errorf("failed to select: %w", err)
+	}
+
+	return sqlRes, nil
+}
+
+func (r repository) InsertGame(ctx context.Context, q domain.InsertGameQuery) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO public.list_games (list_slug, game_slug, label, description, position)
+		SELECT $1, $2, $3, $4, COALESCE(MAX(position) + 1, 0)
+		FROM public.list_games
+		WHERE list_slug = $1
+	`, q.ListSlug, q.GameSlug, toNullString(q.Label), toNullString(q.Description))
+	if err != nil {
+		return fmt.Errorf("failed to insert: %w", err)
+	}
+
+	return nil
+}
+
+func (r repository) DeleteGame(ctx context.Context, q domain.DeleteGameQuery) error {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM public.list_games
+		WHERE list_slug = $1 AND game_slug = $2
+	`, q.ListSlug, q.GameSlug)
+	if err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %q", domain.ErrGameNotInList, q.GameSlug)
+	}
+
+	return nil
+}
+
+func (r repository) UpdatePositions(ctx context.Context, q domain.UpdatePositionsQuery) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			zerolog.Ctx(ctx).Error().Err(fmt.Errorf("failed to rollback: %w", err)).Send()
+		}
+	}()
+
+	for position, gameSlug := range q.GameSlugs {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE public.list_games
+			SET position = $1
+			WHERE list_slug = $2 AND game_slug = $3
+		`, position, q.ListSlug, gameSlug)
+		if err != nil {
+			return fmt.Errorf("failed to update position of %q: %w", gameSlug, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func toNullString(s string) sql.NullString {
+	return sql.NullString{
+		String: s,
+		Valid:  s != "",
+	}
+}
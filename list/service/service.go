@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/list/domain"
+)
+
+type Config struct {
+	Repository domain.Repository
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Repository == nil, fmt.Errorf("empty repository"))
+
+	return err.Err()
+}
+
+type service struct {
+	repository domain.Repository
+}
+
+func New(cfg Config) domain.Service {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &service{
+		repository: cfg.Repository,
+	}
+}
+
+func (s service) List(ctx context.Context, req domain.ListRequest) (domain.ListResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.ListResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	repoRes, err := s.repository.Find(ctx, domain.FindQuery(req))
+	if err != nil {
+		return domain.ListResponse{}, fmt.Errorf("failed to find: %w", err)
+	}
+
+	res := domain.ListResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.ListResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) Get(ctx context.Context, req domain.GetRequest) (domain.GetResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.GetResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	repoRes, err := s.repository.FindOne(ctx, domain.FindOneQuery(req))
+	if err != nil {
+		return domain.GetResponse{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	res := domain.GetResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.GetResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) AddGame(ctx context.Context, req domain.AddGameRequest) (domain.AddGameResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.AddGameResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	list, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+		Slug: req.ListSlug,
+	})
+	if err != nil {
+		return domain.AddGameResponse{}, fmt.Errorf("failed to find list: %w", err)
+	}
+
+	if _, ok := list.Data.Games.FindBySlug(req.GameSlug); ok {
+		return domain.AddGameResponse{}, fmt.Errorf("%w: %q", domain.ErrGameAlreadyInList, req.GameSlug)
+	}
+
+	if err := s.repository.InsertGame(ctx, domain.InsertGameQuery(req)); err != nil {
+		return domain.AddGameResponse{}, fmt.Errorf("failed to insert game: %w", err)
+	}
+
+	repoRes, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+		Slug: req.ListSlug,
+	})
+	if err != nil {
+		return domain.AddGameResponse{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	res := domain.AddGameResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.AddGameResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) RemoveGame(ctx context.Context, req domain.RemoveGameRequest) (domain.RemoveGameResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.RemoveGameResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	if err := s.repository.DeleteGame(ctx, domain.DeleteGameQuery(req)); err != nil {
+		return domain.RemoveGameResponse{}, fmt.Errorf("failed to delete game: %w", err)
+	}
+
+	repoRes, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+		Slug: req.ListSlug,
+	})
+	if err != nil {
+		return domain.RemoveGameResponse{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	res := domain.RemoveGameResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.RemoveGameResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) Reorder(ctx context.Context, req domain.ReorderRequest) (domain.ReorderResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.ReorderResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	list, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+		Slug: req.ListSlug,
+	})
+	if err != nil {
+		return domain.ReorderResponse{}, fmt.Errorf("failed to find list: %w", err)
+	}
+
+	if err := validateOrder(list.Data.Games, req.GameSlugs); err != nil {
+		return domain.ReorderResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	err = s.repository.UpdatePositions(ctx, domain.UpdatePositionsQuery(req))
+	if err != nil {
+		return domain.ReorderResponse{}, fmt.Errorf("failed to update positions: %w", err)
+	}
+
+	repoRes, err := s.repository.FindOne(ctx, domain.FindOneQuery{
+		Slug: req.ListSlug,
+	})
+	if err != nil {
+		return domain.ReorderResponse{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	res := domain.ReorderResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.ReorderResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func validateOrder(games domain.Games, slugs []string) error {
+	var err zeroerror.Error
+
+	for _, slug := range slugs {
+		if _, ok := games.FindBySlug(slug); !ok {
+			err.Add(fmt.Errorf("%w: %q", domain.ErrGameNotInList, slug))
+		}
+	}
+
+	err.AddIf(len(slugs) != len(games), fmt.Errorf(
+		"expected %d game slugs, got %d", len(games), len(slugs),
+	))
+
+	return err.Err()
+}
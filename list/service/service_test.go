@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vediagames/platform/list/domain"
+)
+
+type fakeRepository struct {
+	domain.Repository
+	list      domain.List
+	inserted  []domain.InsertGameQuery
+	positions []domain.UpdatePositionsQuery
+}
+
+func (r *fakeRepository) Find(_ context.Context, _ domain.FindQuery) (domain.FindResult, error) {
+	return domain.FindResult{
+		Data: domain.Lists{Data: []domain.List{r.list}, Total: 1},
+	}, nil
+}
+
+func (r *fakeRepository) FindOne(_ context.Context, q domain.FindOneQuery) (domain.FindOneResult, error) {
+	if q.Slug != r.list.Slug {
+		return domain.FindOneResult{}, domain.ErrNoData
+	}
+
+	return domain.FindOneResult{Data: r.list}, nil
+}
+
+func (r *fakeRepository) InsertGame(_ context.Context, q domain.InsertGameQuery) error {
+	r.inserted = append(r.inserted, q)
+
+	r.list.Games = append(r.list.Games, domain.Game{
+		Slug:       q.GameSlug,
+		Position:   len(r.list.Games),
+		InsertedAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (r *fakeRepository) UpdatePositions(_ context.Context, q domain.UpdatePositionsQuery) error {
+	r.positions = append(r.positions, q)
+
+	return nil
+}
+
+func newFakeRepository(slugs ...string) *fakeRepository {
+	r := &fakeRepository{
+		list: domain.List{
+			Slug: "trending",
+			Name: "Trending",
+		},
+	}
+
+	for i, slug := range slugs {
+		r.list.Games = append(r.list.Games, domain.Game{
+			Slug:       slug,
+			Position:   i,
+			InsertedAt: time.Now(),
+		})
+	}
+
+	return r
+}
+
+func TestService_List(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.ListRequest
+		wantErr error
+	}{
+		{
+			name: "valid",
+			req:  domain.ListRequest{Page: 1, Limit: 10},
+		},
+		{
+			name:    "zero limit",
+			req:     domain.ListRequest{Page: 1, Limit: 0},
+			wantErr: domain.ErrInvalidLimit,
+		},
+		{
+			name:    "zero page",
+			req:     domain.ListRequest{Page: 0, Limit: 10},
+			wantErr: domain.ErrInvalidPage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(Config{Repository: newFakeRepository("a")})
+
+			_, err := s.List(context.Background(), tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("List() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_AddGame(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        domain.AddGameRequest
+		wantErr    error
+		wantInsert bool
+	}{
+		{
+			name:       "new game",
+			req:        domain.AddGameRequest{ListSlug: "trending", GameSlug: "c"},
+			wantInsert: true,
+		},
+		{
+			name:    "already in list",
+			req:     domain.AddGameRequest{ListSlug: "trending", GameSlug: "a"},
+			wantErr: domain.ErrGameAlreadyInList,
+		},
+		{
+			name:    "empty game slug",
+			req:     domain.AddGameRequest{ListSlug: "trending"},
+			wantErr: domain.ErrEmptyGameSlug,
+		},
+		{
+			name:    "empty list slug",
+			req:     domain.AddGameRequest{GameSlug: "c"},
+			wantErr: domain.ErrEmptyListSlug,
+		},
+		{
+			name:    "unknown list",
+			req:     domain.AddGameRequest{ListSlug: "popular", GameSlug: "c"},
+			wantErr: domain.ErrNoData,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository("a", "b")
+			s := New(Config{Repository: repo})
+
+			res, err := s.AddGame(context.Background(), tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddGame() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got := len(repo.inserted) > 0; got != tt.wantInsert {
+				t.Errorf("inserted = %t, want %t", got, tt.wantInsert)
+			}
+
+			if tt.wantInsert {
+				if _, ok := res.Data.Games.FindBySlug(tt.req.GameSlug); !ok {
+					t.Errorf("response games = %v, want %q in them", res.Data.Games.Slugs(), tt.req.GameSlug)
+				}
+			}
+		})
+	}
+}
+
+func TestService_Reorder(t *testing.T) {
+	tests := []struct {
+		name    string
+		slugs   []string
+		wantErr bool
+		is      error
+	}{
+		{
+			name:  "every game once",
+			slugs: []string{"c", "a", "b"},
+		},
+		{
+			name:    "game not in list",
+			slugs:   []string{"c", "a", "d"},
+			wantErr: true,
+			is:      domain.ErrGameNotInList,
+		},
+		{
+			name:    "duplicate game",
+			slugs:   []string{"a", "a", "b"},
+			wantErr: true,
+			is:      domain.ErrDuplicateGameSlug,
+		},
+		{
+			name:    "empty game slug",
+			slugs:   []string{"a", "", "b"},
+			wantErr: true,
+			is:      domain.ErrEmptyGameSlug,
+		},
+		{
+			name:    "missing game",
+			slugs:   []string{"a", "b"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository("a", "b", "c")
+			s := New(Config{Repository: repo})
+
+			_, err := s.Reorder(context.Background(), domain.ReorderRequest{
+				ListSlug:  "trending",
+				GameSlugs: tt.slugs,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Reorder() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if tt.is != nil && !errors.Is(err, tt.is) {
+				t.Errorf("Reorder() error = %v, want %v", err, tt.is)
+			}
+
+			if got := len(repo.positions) > 0; got == tt.wantErr {
+				t.Errorf("updated positions = %t, want %t", got, !tt.wantErr)
+			}
+		})
+	}
+}
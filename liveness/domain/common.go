@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vediagames/zeroerror"
+)
+
+// Status is the outcome of the last check of a game URL.
+type Status struct {
+	GameID int
+	URL    string
+	// StatusCode is 0 when the request failed without a response.
+	StatusCode          int
+	Latency             time.Duration
+	Error               string
+	CheckedAt           time.Time
+	LastSuccessAt       time.Time
+	ConsecutiveFailures int
+	// DisabledAt is set when the game was set invisible for failing.
+	DisabledAt time.Time
+}
+
+func (s Status) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(s.GameID < 1, fmt.Errorf("invalid game ID"))
+	err.AddIf(s.URL == "", fmt.Errorf("empty URL"))
+	err.AddIf(s.CheckedAt.IsZero(), fmt.Errorf("empty checked at"))
+	err.AddIf(s.ConsecutiveFailures < 0, fmt.Errorf("negative consecutive failures"))
+
+	return err.Err()
+}
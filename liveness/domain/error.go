@@ -0,0 +1,13 @@
+package domain
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrInvalidPage  = Error("invalid page")
+	ErrInvalidLimit = Error("invalid limit")
+	ErrInvalidData  = Error("invalid data")
+)
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Save(context.Context, SaveQuery) (SaveResult, error)
+	MarkDisabled(context.Context, MarkDisabledQuery) error
+	Find(context.Context, FindQuery) (FindResult, error)
+}
+
+// SaveQuery records a check. Consecutive failures restart when the game URL
+// changed since the previous check.
+type SaveQuery struct {
+	GameID     int
+	URL        string
+	StatusCode int
+	Latency    time.Duration
+	Error      string
+	OK         bool
+	CheckedAt  time.Time
+}
+
+type SaveResult struct {
+	ConsecutiveFailures int
+}
+
+type MarkDisabledQuery struct {
+	GameID int
+}
+
+type FindQuery struct {
+	Page        int
+	Limit       int
+	FailingOnly bool
+}
+
+type FindResult struct {
+	Data  []Status
+	Total int
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+)
+
+type Service interface {
+	List(context.Context, ListRequest) (ListResponse, error)
+	CheckAll(context.Context) (CheckAllResponse, error)
+}
+
+type ListRequest struct {
+	Page  int
+	Limit int
+	// FailingOnly lists games whose last check failed.
+	FailingOnly bool
+}
+
+func (r ListRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Page < 1, ErrInvalidPage)
+	err.AddIf(r.Limit < 1 || r.Limit > 100, ErrInvalidLimit)
+
+	return err.Err()
+}
+
+type ListResponse struct {
+	Data  []Status
+	Total int
+}
+
+func (r ListResponse) Validate() error {
+	var err zeroerror.Error
+
+	for i, s := range r.Data {
+		if ve := s.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at index %d: %w", ErrInvalidData, i, ve))
+		}
+	}
+
+	err.AddIf(r.Total < 0, fmt.Errorf("%w: negative total", ErrInvalidData))
+
+	return err.Err()
+}
+
+type CheckAllResponse struct {
+	Checked int
+	Failed  int
+	// Disabled are the games set invisible by this run.
+	Disabled []int
+}
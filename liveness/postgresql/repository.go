@@ -0,0 +1,164 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/liveness/domain"
+)
+
+type Config struct {
+	DB *sqlx.DB
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.DB == nil, fmt.Errorf("empty DB"))
+
+	if pingErr := c.DB.Ping(); pingErr != nil {
+		err.Add(fmt.Errorf("failed to ping: %w", pingErr))
+	}
+
+	return err.Err()
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+func New(cfg Config) domain.Repository {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &repository{
+		db: cfg.DB,
+	}
+}
+
+// Save is only called for published games, so a game that was disabled
+// before has been published again and starts counting failures anew.
+func (r repository) Save(ctx context.Context, q domain.SaveQuery) (domain.SaveResult, error) {
+	var res domain.SaveResult
+
+	err := r.db.GetContext(ctx, &res.ConsecutiveFailures, `
+		INSERT INTO public.game_liveness (
+			game_id,
+			url,
+			status_code,
+			latency_ms,
+			error,
+			checked_at,
+			last_success_at,
+			consecutive_failures
+		)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5,
+			$6,
+			CASE WHEN $7 THEN $6::TIMESTAMPTZ END,
+			CASE WHEN $7 THEN 0 ELSE 1 END
+		)
+		ON CONFLICT (game_id) DO UPDATE
+		SET
+			url = EXCLUDED.url,
+			status_code = EXCLUDED.status_code,
+			latency_ms = EXCLUDED.latency_ms,
+			error = EXCLUDED.error,
+			checked_at = EXCLUDED.checked_at,
+			last_success_at = COALESCE(EXCLUDED.last_success_at, game_liveness.last_success_at),
+			consecutive_failures = CASE
+				WHEN $7 THEN 0
+				WHEN game_liveness.url != EXCLUDED.url OR game_liveness.disabled_at IS NOT NULL THEN 1
+				ELSE game_liveness.consecutive_failures + 1
+			END,
+			disabled_at = NULL
+		RETURNING consecutive_failures
+	`, q.GameID, q.URL, q.StatusCode, q.Latency.Milliseconds(), q.Error, q.CheckedAt, q.OK)
+	if err != nil {
+		return domain.SaveResult{}, fmt.Errorf("failed to get: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r repository) MarkDisabled(ctx context.Context, q domain.MarkDisabledQuery) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE public.game_liveness
+		SET disabled_at = NOW()
+		WHERE game_id = $1
+	`, q.GameID)
+	if err != nil {
+		return fmt.Errorf("failed to exec: %w", err)
+	}
+
+	return nil
+}
+
+type status struct {
+	GameID              int         `db:"game_id"`
+	URL                 string      `db:"url"`
+	StatusCode          int         `db:"status_code"`
+	LatencyMS           int64       `db:"latency_ms"`
+	Error               string      `db:"error"`
+	CheckedAt           time.Time   `db:"checked_at"`
+	LastSuccessAt       pq.NullTime `db:"last_success_at"`
+	ConsecutiveFailures int         `db:"consecutive_failures"`
+	DisabledAt          pq.NullTime `db:"disabled_at"`
+	TotalCount          int         `db:"total_count"`
+}
+
+func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindResult, error) {
+	var rows []status
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT
+			game_id,
+			url,
+			status_code,
+			latency_ms,
+			error,
+			checked_at,
+			last_success_at,
+			consecutive_failures,
+			disabled_at,
+			COUNT(*) OVER() AS total_count
+		FROM public.game_liveness
+		WHERE NOT $1 OR consecutive_failures > 0
+		ORDER BY consecutive_failures DESC, checked_at DESC, game_id
+		LIMIT $2 OFFSET $3
+	`, q.FailingOnly, q.Limit, (q.Page-1)*q.Limit)
+	if err != nil {
+		return domain.FindResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindResult{
+		Data: make([]domain.Status, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Total = row.TotalCount
+		res.Data = append(res.Data, domain.Status{
+			GameID:              row.GameID,
+			URL:                 row.URL,
+			StatusCode:          row.StatusCode,
+			Latency:             time.Duration(row.LatencyMS) * time.Millisecond,
+			Error:               row.Error,
+			CheckedAt:           row.CheckedAt,
+			LastSuccessAt:       row.LastSuccessAt.Time,
+			ConsecutiveFailures: row.ConsecutiveFailures,
+			DisabledAt:          row.DisabledAt.Time,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// hostLimiter spaces requests to the same host by at least interval. Most
+// games are served from a handful of provider hosts, so this keeps the
+// checker from hammering them while other hosts are checked in parallel.
+type hostLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     map[string]time.Time
+	now      func() time.Time
+}
+
+func newHostLimiter(interval time.Duration) *hostLimiter {
+	return &hostLimiter{
+		interval: interval,
+		next:     make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+// reserve returns the time a request to host may start at and holds it, the
+// request after it gets a time one interval later.
+func (l *hostLimiter) reserve(host string) time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	at := l.next[host]
+	if at.Before(now) {
+		at = now
+	}
+
+	l.next[host] = at.Add(l.interval)
+
+	return at
+}
+
+func (l *hostLimiter) wait(ctx context.Context, host string) error {
+	d := l.reserve(host).Sub(l.now())
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
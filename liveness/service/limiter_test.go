@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHostLimiter_Reserve(t *testing.T) {
+	start := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+
+	l := newHostLimiter(5 * time.Second)
+	l.now = func() time.Time { return now }
+
+	tests := []struct {
+		host    string
+		advance time.Duration
+		want    time.Duration
+	}{
+		{host: "a", want: 0},
+		{host: "a", want: 5 * time.Second},
+		// Other hosts are not held up by a.
+		{host: "b", want: 0},
+		{host: "a", advance: time.Second, want: 10 * time.Second},
+		// A host that was idle for longer than the interval is not waited for.
+		{host: "a", advance: 20 * time.Second, want: 21 * time.Second},
+		{host: "a", want: 26 * time.Second},
+		{host: "b", want: 21 * time.Second},
+	}
+
+	for i, tt := range tests {
+		now = now.Add(tt.advance)
+
+		if got := l.reserve(tt.host).Sub(start); got != tt.want {
+			t.Errorf("reserve %d for %q = start + %s, want start + %s", i, tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestHostLimiter_WaitCanceled(t *testing.T) {
+	l := newHostLimiter(time.Hour)
+
+	if err := l.wait(context.Background(), "a"); err != nil {
+		t.Fatalf("first wait error = %v, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.wait(ctx, "a"); !errors.Is(err, context.Canceled) {
+		t.Errorf("second wait error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	gamedomain "github.com/vediagames/platform/game/domain"
+	"github.com/vediagames/platform/liveness/domain"
+)
+
+type Config struct {
+	Repository  domain.Repository
+	GameService gamedomain.Service
+	Client      *http.Client
+	// Interval is the time between two checks of all published games.
+	Interval time.Duration
+	// Concurrency is the number of URLs checked at once.
+	Concurrency int
+	// HostInterval is the minimum time between two requests to one host.
+	HostInterval time.Duration
+	// MaxFailures is the number of consecutive failed checks after which a
+	// game is set invisible.
+	MaxFailures int
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Repository == nil, fmt.Errorf("empty repository"))
+	err.AddIf(c.GameService == nil, fmt.Errorf("empty game service"))
+	err.AddIf(c.Client == nil, fmt.Errorf("empty client"))
+	err.AddIf(c.Interval <= 0, fmt.Errorf("interval must be positive"))
+	err.AddIf(c.Concurrency < 1, fmt.Errorf("concurrency must be positive"))
+	err.AddIf(c.HostInterval < 0, fmt.Errorf("host interval must not be negative"))
+	err.AddIf(c.MaxFailures < 1, fmt.Errorf("max failures must be positive"))
+
+	return err.Err()
+}
+
+// Service checks that the URLs of published games respond. It implements
+// domain.Service and runs checks periodically through Run.
+type Service struct {
+	repository  domain.Repository
+	gameService gamedomain.Service
+	client      *http.Client
+	interval    time.Duration
+	concurrency int
+	limiter     *hostLimiter
+	maxFailures int
+}
+
+var _ domain.Service = &Service{}
+
+func New(cfg Config) *Service {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &Service{
+		repository:  cfg.Repository,
+		gameService: cfg.GameService,
+		client:      cfg.Client,
+		interval:    cfg.Interval,
+		concurrency: cfg.Concurrency,
+		limiter:     newHostLimiter(cfg.HostInterval),
+		maxFailures: cfg.MaxFailures,
+	}
+}
+
+func (s *Service) List(ctx context.Context, req domain.ListRequest) (domain.ListResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.ListResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	repoRes, err := s.repository.Find(ctx, domain.FindQuery(req))
+	if err != nil {
+		return domain.ListResponse{}, fmt.Errorf("failed to find: %w", err)
+	}
+
+	res := domain.ListResponse(repoRes)
+	if err := res.Validate(); err != nil {
+		return domain.ListResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+// Run checks all published games every interval until ctx is done.
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			res, err := s.CheckAll(ctx)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to check game URLs")
+				continue
+			}
+
+			zerolog.Ctx(ctx).Info().
+				Int("checked", res.Checked).
+				Int("failed", res.Failed).
+				Ints("disabled", res.Disabled).
+				Msg("checked game URLs")
+		}
+	}
+}
+
+const listLimit = 100
+
+// author is recorded on the game revisions of disabled games.
+const author = "liveness"
+
+// CheckAll checks the URL of every published game. Games are listed up
+// front, so games set invisible during the run do not shift the pages.
+func (s *Service) CheckAll(ctx context.Context) (domain.CheckAllResponse, error) {
+	var games []gamedomain.Game
+
+	for page := 1; ; page++ {
+		listRes, err := s.gameService.List(ctx, gamedomain.ListRequest{
+			Language: gamedomain.LanguageEnglish,
+			Page:     page,
+			Limit:    listLimit,
+			Sort:     gamedomain.SortingMethodID,
+		})
+		if err != nil {
+			return domain.CheckAllResponse{}, fmt.Errorf("failed to list games: %w", err)
+		}
+
+		games = append(games, listRes.Data.Data...)
+
+		if len(listRes.Data.Data) < listLimit {
+			break
+		}
+	}
+
+	var (
+		res  domain.CheckAllResponse
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		jobs = make(chan gamedomain.Game)
+	)
+
+	for i := 0; i < s.concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for game := range jobs {
+				ok, disabled, err := s.checkGame(ctx, game)
+				if err != nil {
+					zerolog.Ctx(ctx).Error().Err(err).Int("game_id", game.ID).Msg("failed to check game URL")
+				}
+
+				mu.Lock()
+				res.Checked++
+				if !ok {
+					res.Failed++
+				}
+				if disabled {
+					res.Disabled = append(res.Disabled, game.ID)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, game := range games {
+		select {
+		case jobs <- game:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// checkGame checks the URL of a game, records the result and disables the
+// game once it failed maxFailures times in a row.
+func (s *Service) checkGame(ctx context.Context, game gamedomain.Game) (bool, bool, error) {
+	r := s.check(ctx, game.URL)
+	if ctx.Err() != nil {
+		return true, false, nil
+	}
+
+	saveRes, err := s.repository.Save(ctx, domain.SaveQuery{
+		GameID:     game.ID,
+		URL:        game.URL,
+		StatusCode: r.statusCode,
+		Latency:    r.latency,
+		Error:      r.err,
+		OK:         r.ok(),
+		CheckedAt:  r.checkedAt,
+	})
+	if err != nil {
+		return r.ok(), false, fmt.Errorf("failed to save: %w", err)
+	}
+
+	if r.ok() || saveRes.ConsecutiveFailures < s.maxFailures {
+		return r.ok(), false, nil
+	}
+
+	disabled, err := s.disable(ctx, game.ID)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to disable: %w", err)
+	}
+
+	return false, disabled, nil
+}
+
+// disable sets the game invisible. The game is read again first, the one the
+// check started with may be outdated by now. Games that are not published
+// anymore are left as they are.
+func (s *Service) disable(ctx context.Context, id int) (bool, error) {
+	gameRes, err := s.gameService.Get(ctx, gamedomain.GetRequest{
+		Field:    gamedomain.GetByFieldID,
+		Value:    id,
+		Language: gamedomain.LanguageEnglish,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	game := gameRes.Data
+	if game.Status != gamedomain.StatusPublished {
+		return false, nil
+	}
+
+	_, err = s.gameService.Edit(ctx, gamedomain.EditRequest{
+		ID:             game.ID,
+		Slug:           game.Slug,
+		Mobile:         game.Mobile,
+		TagIDRefs:      game.TagIDRefs,
+		CategoryIDRefs: game.CategoryIDRefs,
+		Status:         gamedomain.StatusInvisible,
+		URL:            game.URL,
+		Width:          game.Width,
+		Height:         game.Height,
+		Weight:         game.Weight,
+		Author:         author,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to edit game: %w", err)
+	}
+
+	if err := s.repository.MarkDisabled(ctx, domain.MarkDisabledQuery{
+		GameID: game.ID,
+	}); err != nil {
+		return false, fmt.Errorf("failed to mark disabled: %w", err)
+	}
+
+	return true, nil
+}
+
+type result struct {
+	statusCode int
+	latency    time.Duration
+	err        string
+	checkedAt  time.Time
+}
+
+func (r result) ok() bool {
+	return r.err == "" && r.statusCode < http.StatusBadRequest
+}
+
+// check sends a HEAD request and falls back to GET when it fails, some hosts
+// do not allow HEAD.
+func (s *Service) check(ctx context.Context, rawURL string) result {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return result{
+			err:       fmt.Sprintf("invalid URL: %q", rawURL),
+			checkedAt: time.Now(),
+		}
+	}
+
+	r := s.request(ctx, http.MethodHead, u)
+	if r.ok() {
+		return r
+	}
+
+	return s.request(ctx, http.MethodGet, u)
+}
+
+func (s *Service) request(ctx context.Context, method string, u *url.URL) result {
+	if err := s.limiter.wait(ctx, u.Host); err != nil {
+		return result{
+			err:       err.Error(),
+			checkedAt: time.Now(),
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return result{
+			err:       fmt.Sprintf("failed to create request: %s", err),
+			checkedAt: time.Now(),
+		}
+	}
+
+	start := time.Now()
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return result{
+			latency:   time.Since(start),
+			err:       err.Error(),
+			checkedAt: start,
+		}
+	}
+	defer res.Body.Close()
+
+	// Draining a little lets the connection be reused without downloading
+	// whole game pages.
+	_, _ = io.CopyN(io.Discard, res.Body, 4096)
+
+	return result{
+		statusCode: res.StatusCode,
+		latency:    time.Since(start),
+		checkedAt:  start,
+	}
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	gamedomain "github.com/vediagames/platform/game/domain"
+	"github.com/vediagames/platform/liveness/domain"
+)
+
+type fakeRepository struct {
+	domain.Repository
+	mu       sync.Mutex
+	failures map[int]int
+	disabled map[int]bool
+}
+
+func (r *fakeRepository) Save(_ context.Context, q domain.SaveQuery) (domain.SaveResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if q.OK {
+		r.failures[q.GameID] = 0
+	} else {
+		r.failures[q.GameID]++
+	}
+
+	return domain.SaveResult{ConsecutiveFailures: r.failures[q.GameID]}, nil
+}
+
+func (r *fakeRepository) MarkDisabled(_ context.Context, q domain.MarkDisabledQuery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.disabled[q.GameID] = true
+
+	return nil
+}
+
+type fakeGameService struct {
+	gamedomain.Service
+	mu    sync.Mutex
+	games []gamedomain.Game
+}
+
+func (s *fakeGameService) List(_ context.Context, req gamedomain.ListRequest) (gamedomain.ListResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var published []gamedomain.Game
+	for _, g := range s.games {
+		if g.Status == gamedomain.StatusPublished {
+			published = append(published, g)
+		}
+	}
+
+	start := (req.Page - 1) * req.Limit
+	if start > len(published) {
+		start = len(published)
+	}
+
+	end := start + req.Limit
+	if end > len(published) {
+		end = len(published)
+	}
+
+	return gamedomain.ListResponse{
+		Data: gamedomain.Games{Data: published[start:end], Total: len(published)},
+	}, nil
+}
+
+func (s *fakeGameService) Get(_ context.Context, req gamedomain.GetRequest) (gamedomain.GetResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, g := range s.games {
+		if g.ID == req.Value {
+			return gamedomain.GetResponse{Data: g}, nil
+		}
+	}
+
+	return gamedomain.GetResponse{}, gamedomain.ErrNoData
+}
+
+func (s *fakeGameService) Edit(_ context.Context, req gamedomain.EditRequest) (gamedomain.EditResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, g := range s.games {
+		if g.ID == req.ID {
+			s.games[i].Status = req.Status
+		}
+	}
+
+	return gamedomain.EditResponse{}, nil
+}
+
+func TestService_CheckAll(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		key := r.Method + " " + r.URL.Path
+		calls[key]++
+
+		switch r.URL.Path {
+		case "/healthy":
+			w.WriteHeader(http.StatusOK)
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/flapping":
+			// Fails every second check, never twice in a row.
+			if calls["HEAD /flapping"]%2 == 0 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/down":
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	games := &fakeGameService{}
+	for i, path := range []string{"/healthy", "/no-head", "/flapping", "/down"} {
+		games.games = append(games.games, gamedomain.Game{
+			ID:     i + 1,
+			Slug:   path[1:],
+			Status: gamedomain.StatusPublished,
+			URL:    srv.URL + path,
+		})
+	}
+
+	repo := &fakeRepository{
+		failures: make(map[int]int),
+		disabled: make(map[int]bool),
+	}
+
+	s := New(Config{
+		Repository:   repo,
+		GameService:  games,
+		Client:       srv.Client(),
+		Interval:     time.Minute,
+		Concurrency:  4,
+		HostInterval: time.Millisecond,
+		MaxFailures:  2,
+	})
+
+	var disabled []int
+
+	for run := 0; run < 3; run++ {
+		res, err := s.CheckAll(context.Background())
+		if err != nil {
+			t.Fatalf("CheckAll() error = %v", err)
+		}
+
+		disabled = append(disabled, res.Disabled...)
+	}
+
+	sort.Ints(disabled)
+
+	if len(disabled) != 1 || disabled[0] != 4 {
+		t.Errorf("disabled = %v, want [4]", disabled)
+	}
+
+	if !repo.disabled[4] || games.games[3].Status != gamedomain.StatusInvisible {
+		t.Errorf("down game was not set invisible")
+	}
+
+	for _, id := range []int{1, 2, 3} {
+		if games.games[id-1].Status != gamedomain.StatusPublished {
+			t.Errorf("game %d status = %s, want published", id, games.games[id-1].Status)
+		}
+	}
+
+	if calls["HEAD /healthy"] != 3 || calls["GET /healthy"] != 0 {
+		t.Errorf("healthy calls = %v, want HEAD only", calls)
+	}
+
+	if calls["GET /flapping"] != 1 {
+		t.Errorf("GET /flapping calls = %d, want 1 for the failed check", calls["GET /flapping"])
+	}
+
+	if calls["GET /no-head"] != 3 {
+		t.Errorf("GET /no-head calls = %d, want 3", calls["GET /no-head"])
+	}
+}
+
+func TestService_DisableRereadsGame(t *testing.T) {
+	games := &fakeGameService{
+		games: []gamedomain.Game{
+			{ID: 1, Status: gamedomain.StatusDeleted},
+			{ID: 2, Status: gamedomain.StatusPublished, Plays: 10},
+		},
+	}
+
+	repo := &fakeRepository{
+		failures: make(map[int]int),
+		disabled: make(map[int]bool),
+	}
+
+	s := New(Config{
+		Repository:  repo,
+		GameService: games,
+		Client:      http.DefaultClient,
+		Interval:    time.Minute,
+		Concurrency: 1,
+		MaxFailures: 1,
+	})
+
+	// The game was deleted after the check listed it.
+	disabled, err := s.disable(context.Background(), 1)
+	if err != nil || disabled {
+		t.Errorf("disable(1) = %t, %v, want false, nil", disabled, err)
+	}
+
+	if games.games[0].Status != gamedomain.StatusDeleted || repo.disabled[1] {
+		t.Errorf("deleted game was changed")
+	}
+
+	disabled, err = s.disable(context.Background(), 2)
+	if err != nil || !disabled {
+		t.Errorf("disable(2) = %t, %v, want true, nil", disabled, err)
+	}
+
+	if games.games[1].Status != gamedomain.StatusInvisible || !repo.disabled[2] {
+		t.Errorf("published game was not set invisible")
+	}
+}
@@ -27,6 +27,9 @@ func main() {
 	rootCmd.AddCommand(cmd.MigrateCmd())
 	rootCmd.AddCommand(cmd.StubCmd())
 	rootCmd.AddCommand(cmd.QuotesCmd())
+	rootCmd.AddCommand(cmd.ImportCmd())
+	rootCmd.AddCommand(cmd.SyncCmd())
+	rootCmd.AddCommand(cmd.PurgeCmd())
 
 	zerolog.TimestampFieldName = "t"
 	zerolog.LevelFieldName = "l"
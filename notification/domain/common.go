@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/vediagames/zeroerror"
+import (
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+)
 
 type User struct {
 	Email string
@@ -20,3 +24,52 @@ func (u User) Validate() error {
 
 	return err.Err()
 }
+
+type Language string
+
+func (l Language) Validate() error {
+	switch l {
+	case LanguageEnglish, LanguageEspanol:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrInvalidValue, l)
+}
+
+func (l Language) String() string {
+	return string(l)
+}
+
+const (
+	LanguageEnglish Language = "en"
+	LanguageEspanol Language = "es"
+)
+
+// Template names an email template. Every template has an English variant,
+// other languages fall back to it.
+type Template string
+
+func (t Template) Validate() error {
+	switch t {
+	case TemplateContactForm:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrInvalidValue, t)
+}
+
+func (t Template) String() string {
+	return string(t)
+}
+
+const (
+	TemplateContactForm Template = "contact_form"
+)
+
+// ContactFormData is the data of TemplateContactForm.
+type ContactFormData struct {
+	Name    string
+	Email   string
+	Subject string
+	Body    string
+}
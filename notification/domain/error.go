@@ -11,4 +11,7 @@ const (
 	ErrEmptyBody    = Error("empty body")
 	ErrEmptyEmail   = Error("empty email")
 	ErrEmptyName    = Error("empty name")
+	ErrInvalidValue = Error("invalid value")
+	ErrQueueClosed  = Error("queue closed")
+	ErrQueueFull    = Error("queue full")
 )
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+)
+
+// Service renders templated emails and hands them to an EmailClient.
+type Service interface {
+	Send(context.Context, SendRequest) error
+}
+
+type SendRequest struct {
+	To       User
+	From     User
+	Template Template
+	Language Language
+	// Data is passed to the template as is.
+	Data any
+}
+
+func (r SendRequest) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.To.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid to: %w", ve))
+	}
+
+	if ve := r.From.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid from: %w", ve))
+	}
+
+	if ve := r.Template.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid template: %w", ve))
+	}
+
+	if ve := r.Language.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid language: %w", ve))
+	}
+
+	return err.Err()
+}
+
+// Renderer renders the subject and HTML body of a template.
+type Renderer interface {
+	Render(RenderQuery) (RenderResult, error)
+}
+
+type RenderQuery struct {
+	Template Template
+	Language Language
+	Data     any
+}
+
+type RenderResult struct {
+	Subject string
+	Body    string
+}
@@ -0,0 +1,263 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/notification/domain"
+)
+
+type Config struct {
+	EmailClient domain.EmailClient
+	Workers     int
+	BufferSize  int
+	// MaxAttempts is how many times an email is sent before it is dropped.
+	MaxAttempts int
+	// Backoff is the wait before the first retry, it doubles with every
+	// further retry up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.EmailClient == nil, fmt.Errorf("empty email client"))
+	err.AddIf(c.Workers < 1, fmt.Errorf("workers must be positive"))
+	err.AddIf(c.BufferSize < 1, fmt.Errorf("buffer size must be positive"))
+	err.AddIf(c.MaxAttempts < 1, fmt.Errorf("max attempts must be positive"))
+	err.AddIf(c.Backoff <= 0, fmt.Errorf("backoff must be positive"))
+	err.AddIf(c.MaxBackoff < c.Backoff, fmt.Errorf("max backoff must not be less than backoff"))
+
+	return err.Err()
+}
+
+// Queue is an EmailClient that accepts emails right away and sends them
+// through the wrapped client from Run, retrying with exponential backoff.
+// A provider outage therefore does not fail the caller until the buffer is
+// full.
+//
+// Emails still queued or waiting for a retry when the process dies are lost.
+type Queue struct {
+	emailClient domain.EmailClient
+	workers     int
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	jobs        chan job
+	closed      atomic.Bool
+	now         func() time.Time
+
+	mu      sync.Mutex
+	retries []job
+}
+
+type job struct {
+	req     domain.EmailRequest
+	attempt int
+	// due is when a failed email is tried again.
+	due time.Time
+}
+
+func New(cfg Config) *Queue {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &Queue{
+		emailClient: cfg.EmailClient,
+		workers:     cfg.Workers,
+		maxAttempts: cfg.MaxAttempts,
+		backoff:     cfg.Backoff,
+		maxBackoff:  cfg.MaxBackoff,
+		jobs:        make(chan job, cfg.BufferSize),
+		now:         time.Now,
+	}
+}
+
+// Email queues the email. When the queue is full ErrQueueFull is returned,
+// the provider is never called from here.
+func (q *Queue) Email(ctx context.Context, req domain.EmailRequest) error {
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("invalid request: %w", err)
+	}
+
+	if q.closed.Load() {
+		return domain.ErrQueueClosed
+	}
+
+	select {
+	case q.jobs <- job{req: req}:
+		return nil
+	default:
+		return domain.ErrQueueFull
+	}
+}
+
+// Run sends queued emails until ctx is done. Emails queued or waiting for a
+// retry by then get one last attempt each before Run returns.
+func (q *Queue) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		q.retry(ctx)
+	}()
+
+	for i := 0; i < q.workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			q.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+
+	q.closed.Store(true)
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	q.drain(zerolog.Ctx(ctx).WithContext(drainCtx))
+
+	return nil
+}
+
+func (q *Queue) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j := <-q.jobs:
+			q.send(ctx, j)
+		}
+	}
+}
+
+// send tries the email once. A failed email waits for its retry outside of
+// the workers, so an outage does not hold them up for the whole backoff.
+func (q *Queue) send(ctx context.Context, j job) {
+	j.attempt++
+
+	err := q.emailClient.Email(ctx, j.req)
+	if err == nil {
+		return
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Err(err).
+		Int("attempt", j.attempt).
+		Str("to", j.req.To.Email).
+		Str("subject", j.req.Subject).
+		Logger()
+
+	if j.attempt >= q.maxAttempts {
+		logger.Error().Msg("dropping email")
+		return
+	}
+
+	logger.Warn().Msg("failed to email, retrying")
+
+	j.due = q.now().Add(q.delay(j.attempt))
+
+	q.mu.Lock()
+	q.retries = append(q.retries, j)
+	q.mu.Unlock()
+}
+
+// retry queues failed emails again once they are due, until ctx is done.
+// Retries are checked every backoff, so they may wait up to one backoff
+// longer than their delay.
+func (q *Queue) retry(ctx context.Context) {
+	ticker := time.NewTicker(q.backoff)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.requeue()
+		}
+	}
+}
+
+// requeue moves the due retries to the queue. Those that do not fit wait for
+// the next call.
+func (q *Queue) requeue() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	waiting := q.retries[:0]
+
+	for _, j := range q.retries {
+		if now.Before(j.due) {
+			waiting = append(waiting, j)
+			continue
+		}
+
+		select {
+		case q.jobs <- j:
+		default:
+			waiting = append(waiting, j)
+		}
+	}
+
+	q.retries = waiting
+}
+
+func (q *Queue) delay(attempt int) time.Duration {
+	d := q.backoff
+
+	for i := 1; i < attempt && d < q.maxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > q.maxBackoff {
+		d = q.maxBackoff
+	}
+
+	return d
+}
+
+func (q *Queue) drain(ctx context.Context) {
+	q.mu.Lock()
+	retries := q.retries
+	q.retries = nil
+	q.mu.Unlock()
+
+	for {
+		select {
+		case j := <-q.jobs:
+			q.last(ctx, j)
+		default:
+			for _, j := range retries {
+				q.last(ctx, j)
+			}
+
+			return
+		}
+	}
+}
+
+func (q *Queue) last(ctx context.Context, j job) {
+	if err := q.emailClient.Email(ctx, j.req); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).
+			Str("to", j.req.To.Email).
+			Str("subject", j.req.Subject).
+			Msg("dropping email on shutdown")
+	}
+}
@@ -0,0 +1,232 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vediagames/platform/notification/domain"
+)
+
+type fakeClient struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	sent     []domain.EmailRequest
+}
+
+func (c *fakeClient) Email(_ context.Context, req domain.EmailRequest) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.attempts++
+
+	if c.attempts <= c.failures {
+		return errors.New("provider is down")
+	}
+
+	c.sent = append(c.sent, req)
+
+	return nil
+}
+
+func (c *fakeClient) sentCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.sent)
+}
+
+var request = domain.EmailRequest{
+	To:      domain.User{Email: "to@example.com", Name: "To"},
+	From:    domain.User{Email: "from@example.com", Name: "From"},
+	Subject: "Subject",
+	Body:    "<p>Body</p>",
+}
+
+func TestQueue_RetriesOutage(t *testing.T) {
+	client := &fakeClient{failures: 3}
+
+	q := New(Config{
+		EmailClient: client,
+		Workers:     1,
+		BufferSize:  10,
+		MaxAttempts: 5,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  4 * time.Millisecond,
+	})
+
+	if err := q.Email(context.Background(), request); err != nil {
+		t.Fatalf("Email() error = %v, want nil during outage", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = q.Run(ctx)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for client.sentCount() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	<-done
+
+	if client.sentCount() != 1 || client.attempts != 4 {
+		t.Errorf("sent = %d, attempts = %d, want 1 and 4", client.sentCount(), client.attempts)
+	}
+}
+
+func TestQueue_DropsAfterMaxAttempts(t *testing.T) {
+	client := &fakeClient{failures: 100}
+
+	q := New(Config{
+		EmailClient: client,
+		Workers:     1,
+		BufferSize:  10,
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+
+	j := job{req: request}
+
+	for i := 0; i < 3; i++ {
+		q.send(context.Background(), j)
+
+		if i < 2 {
+			if len(q.retries) != 1 {
+				t.Fatalf("retries after attempt %d = %d, want 1", i+1, len(q.retries))
+			}
+
+			j, q.retries = q.retries[0], nil
+		}
+	}
+
+	if client.attempts != 3 || client.sentCount() != 0 || len(q.retries) != 0 {
+		t.Errorf("attempts = %d, sent = %d, retries = %d, want 3, 0 and 0",
+			client.attempts, client.sentCount(), len(q.retries))
+	}
+}
+
+func TestQueue_EmailFull(t *testing.T) {
+	client := &fakeClient{}
+
+	q := New(Config{
+		EmailClient: client,
+		Workers:     1,
+		BufferSize:  1,
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+
+	if err := q.Email(context.Background(), request); err != nil {
+		t.Fatalf("first Email() error = %v", err)
+	}
+
+	if err := q.Email(context.Background(), request); !errors.Is(err, domain.ErrQueueFull) {
+		t.Errorf("second Email() error = %v, want %v", err, domain.ErrQueueFull)
+	}
+
+	if client.attempts != 0 {
+		t.Errorf("attempts = %d, want the provider not to be called", client.attempts)
+	}
+}
+
+func TestQueue_Requeue(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	q := New(Config{
+		EmailClient: &fakeClient{failures: 1},
+		Workers:     1,
+		BufferSize:  1,
+		MaxAttempts: 3,
+		Backoff:     time.Second,
+		MaxBackoff:  time.Second,
+	})
+	q.now = func() time.Time { return now }
+
+	q.send(context.Background(), job{req: request})
+
+	q.requeue()
+
+	if len(q.jobs) != 0 || len(q.retries) != 1 {
+		t.Fatalf("before due: jobs = %d, retries = %d, want 0 and 1", len(q.jobs), len(q.retries))
+	}
+
+	now = now.Add(time.Second)
+	q.jobs <- job{req: request}
+
+	q.requeue()
+
+	if len(q.retries) != 1 {
+		t.Fatalf("full queue: retries = %d, want the retry to wait", len(q.retries))
+	}
+
+	<-q.jobs
+
+	q.requeue()
+
+	if len(q.jobs) != 1 || len(q.retries) != 0 {
+		t.Errorf("after due: jobs = %d, retries = %d, want 1 and 0", len(q.jobs), len(q.retries))
+	}
+
+	if j := <-q.jobs; j.attempt != 1 {
+		t.Errorf("requeued attempt = %d, want 1", j.attempt)
+	}
+}
+
+func TestQueue_DrainsOnShutdown(t *testing.T) {
+	client := &fakeClient{}
+
+	q := New(Config{
+		EmailClient: client,
+		Workers:     1,
+		BufferSize:  10,
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+
+	for i := 0; i < 3; i++ {
+		if err := q.Email(context.Background(), request); err != nil {
+			t.Fatalf("Email() error = %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_ = q.Run(ctx)
+
+	if client.sentCount() != 3 {
+		t.Errorf("sent = %d, want 3", client.sentCount())
+	}
+
+	if err := q.Email(context.Background(), request); !errors.Is(err, domain.ErrQueueClosed) {
+		t.Errorf("Email() after Run error = %v, want %v", err, domain.ErrQueueClosed)
+	}
+}
+
+func TestQueue_Delay(t *testing.T) {
+	q := &Queue{backoff: time.Second, maxBackoff: 5 * time.Second}
+
+	for attempt, want := range map[int]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		4: 5 * time.Second,
+		9: 5 * time.Second,
+	} {
+		if got := q.delay(attempt); got != want {
+			t.Errorf("delay(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/rs/zerolog"
 	"github.com/vediagames/zeroerror"
@@ -14,14 +15,18 @@ import (
 )
 
 type Config struct {
-	Token  string
-	Client *http.Client
+	Token string
+	// BaseURL is the Brevo API, e.g. https://api.brevo.com. A local mock can
+	// stand in for it.
+	BaseURL string
+	Client  *http.Client
 }
 
 func (c Config) Validate() error {
 	var err zeroerror.Error
 
 	err.AddIf(c.Token == "", fmt.Errorf("empty token"))
+	err.AddIf(c.BaseURL == "", fmt.Errorf("empty base URL"))
 	err.AddIf(c.Client == nil, fmt.Errorf("empty client"))
 
 	return err.Err()
@@ -33,14 +38,16 @@ func New(c Config) domain.EmailClient {
 	}
 
 	return &service{
-		client: c.Client,
-		token:  c.Token,
+		client:  c.Client,
+		token:   c.Token,
+		baseURL: strings.TrimSuffix(c.BaseURL, "/"),
 	}
 }
 
 type service struct {
-	client *http.Client
-	token  string
+	client  *http.Client
+	token   string
+	baseURL string
 }
 
 func (s service) Email(ctx context.Context, req domain.EmailRequest) error {
@@ -58,7 +65,7 @@ func (s service) Email(ctx context.Context, req domain.EmailRequest) error {
 		return fmt.Errorf("failed to marshal email body: %w", err)
 	}
 
-	httpReq, err := http.NewRequest(http.MethodPost, "https://api.brevo.com/v3/smtp/email", bytes.NewReader(b))
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/v3/smtp/email", bytes.NewReader(b))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
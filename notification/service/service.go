@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/notification/domain"
+)
+
+type Config struct {
+	Renderer    domain.Renderer
+	EmailClient domain.EmailClient
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Renderer == nil, fmt.Errorf("empty renderer"))
+	err.AddIf(c.EmailClient == nil, fmt.Errorf("empty email client"))
+
+	return err.Err()
+}
+
+type service struct {
+	renderer    domain.Renderer
+	emailClient domain.EmailClient
+}
+
+func New(cfg Config) domain.Service {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &service{
+		renderer:    cfg.Renderer,
+		emailClient: cfg.EmailClient,
+	}
+}
+
+func (s service) Send(ctx context.Context, req domain.SendRequest) error {
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("invalid request: %w", err)
+	}
+
+	renderRes, err := s.renderer.Render(domain.RenderQuery{
+		Template: req.Template,
+		Language: req.Language,
+		Data:     req.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render: %w", err)
+	}
+
+	emailReq := domain.EmailRequest{
+		To:      req.To,
+		From:    req.From,
+		Name:    req.From.Name,
+		Subject: renderRes.Subject,
+		Body:    renderRes.Body,
+	}
+
+	if err := emailReq.Validate(); err != nil {
+		return fmt.Errorf("invalid rendered email: %w", err)
+	}
+
+	if err := s.emailClient.Email(ctx, emailReq); err != nil {
+		return fmt.Errorf("failed to email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/notification/domain"
+)
+
+type Config struct {
+	Host string
+	Port int
+	// Username and Password are optional, without them no AUTH is sent.
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Host == "", fmt.Errorf("empty host"))
+	err.AddIf(c.Port < 1, fmt.Errorf("invalid port"))
+	err.AddIf(c.Username != "" && c.Password == "", fmt.Errorf("empty password"))
+	err.AddIf(c.Timeout <= 0, fmt.Errorf("timeout must be positive"))
+
+	return err.Err()
+}
+
+func New(c Config) domain.EmailClient {
+	if err := c.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &service{
+		host:     c.Host,
+		addr:     net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		username: c.Username,
+		password: c.Password,
+		timeout:  c.Timeout,
+	}
+}
+
+type service struct {
+	host     string
+	addr     string
+	username string
+	password string
+	timeout  time.Duration
+}
+
+func (s service) Email(ctx context.Context, req domain.EmailRequest) error {
+	msg := message(req)
+
+	dialer := net.Dialer{
+		Timeout: s.timeout,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to dial: %w", err)
+	}
+
+	deadline := time.Now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to set deadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(req.From.Email); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+
+	if err := client.Rcpt(req.To.Email); err != nil {
+		return fmt.Errorf("failed to set recipient: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start data: %w", err)
+	}
+
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("failed to write data: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to close data: %w", err)
+	}
+
+	if err := client.Quit(); err != nil {
+		return fmt.Errorf("failed to quit: %w", err)
+	}
+
+	zerolog.
+		Ctx(ctx).
+		Info().
+		Str("component", "email").
+		Str("provider", "smtp").
+		Send()
+
+	return nil
+}
+
+func message(req domain.EmailRequest) []byte {
+	var b bytes.Buffer
+
+	header := func(key, value string) {
+		fmt.Fprintf(&b, "%s: %s\r\n", key, value)
+	}
+
+	header("From", (&mail.Address{Name: req.From.Name, Address: req.From.Email}).String())
+	header("To", (&mail.Address{Name: req.To.Name, Address: req.To.Email}).String())
+	header("Subject", mime.QEncoding.Encode("utf-8", req.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", `text/html; charset="utf-8"`)
+	header("Content-Transfer-Encoding", "8bit")
+
+	b.WriteString("\r\n")
+	b.WriteString(req.Body)
+
+	return b.Bytes()
+}
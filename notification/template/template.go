@@ -0,0 +1,87 @@
+package template
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+
+	"github.com/vediagames/platform/notification/domain"
+)
+
+//go:embed templates/*.html
+var files embed.FS
+
+// renderer renders the templates in templates/, named
+// <template>.<language>.html. Each file defines a "subject" and a "body".
+type renderer struct {
+	templates map[string]*htmltemplate.Template
+}
+
+func New() domain.Renderer {
+	paths, err := fs.Glob(files, "templates/*.html")
+	if err != nil {
+		panic(fmt.Errorf("failed to glob templates: %w", err))
+	}
+
+	r := &renderer{
+		templates: make(map[string]*htmltemplate.Template, len(paths)),
+	}
+
+	for _, path := range paths {
+		t, err := htmltemplate.ParseFS(files, path)
+		if err != nil {
+			panic(fmt.Errorf("failed to parse %q: %w", path, err))
+		}
+
+		for _, name := range []string{"subject", "body"} {
+			if t.Lookup(name) == nil {
+				panic(fmt.Errorf("template %q does not define %q", path, name))
+			}
+		}
+
+		r.templates[strings.TrimSuffix(strings.TrimPrefix(path, "templates/"), ".html")] = t
+	}
+
+	for _, tmpl := range []domain.Template{domain.TemplateContactForm} {
+		if _, ok := r.templates[key(tmpl, domain.LanguageEnglish)]; !ok {
+			panic(fmt.Errorf("template %q has no English variant", tmpl))
+		}
+	}
+
+	return r
+}
+
+func key(t domain.Template, l domain.Language) string {
+	return t.String() + "." + l.String()
+}
+
+func (r *renderer) Render(q domain.RenderQuery) (domain.RenderResult, error) {
+	t, ok := r.templates[key(q.Template, q.Language)]
+	if !ok {
+		t, ok = r.templates[key(q.Template, domain.LanguageEnglish)]
+	}
+
+	if !ok {
+		return domain.RenderResult{}, fmt.Errorf("%w: %q", domain.ErrInvalidValue, q.Template)
+	}
+
+	var subject, body bytes.Buffer
+
+	if err := t.ExecuteTemplate(&subject, "subject", q.Data); err != nil {
+		return domain.RenderResult{}, fmt.Errorf("failed to execute subject: %w", err)
+	}
+
+	if err := t.ExecuteTemplate(&body, "body", q.Data); err != nil {
+		return domain.RenderResult{}, fmt.Errorf("failed to execute body: %w", err)
+	}
+
+	// The subject is a header, not HTML, so it must not stay escaped.
+	return domain.RenderResult{
+		Subject: strings.TrimSpace(html.UnescapeString(subject.String())),
+		Body:    body.String(),
+	}, nil
+}
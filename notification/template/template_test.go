@@ -0,0 +1,62 @@
+package template
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vediagames/platform/notification/domain"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	r := New()
+
+	data := domain.ContactFormData{
+		Name:    "Ann",
+		Email:   "ann@example.com",
+		Subject: "Games & more",
+		Body:    "<script>alert(1)</script>",
+	}
+
+	tests := []struct {
+		name        string
+		language    domain.Language
+		wantSubject string
+	}{
+		{
+			name:        "english",
+			language:    domain.LanguageEnglish,
+			wantSubject: "Contact form: Games & more",
+		},
+		{
+			name:        "spanish",
+			language:    domain.LanguageEspanol,
+			wantSubject: "Formulario de contacto: Games & more",
+		},
+		{
+			name:        "fallback to english",
+			language:    domain.Language("de"),
+			wantSubject: "Contact form: Games & more",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := r.Render(domain.RenderQuery{
+				Template: domain.TemplateContactForm,
+				Language: tt.language,
+				Data:     data,
+			})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			if res.Subject != tt.wantSubject {
+				t.Errorf("Subject = %q, want %q", res.Subject, tt.wantSubject)
+			}
+
+			if strings.Contains(res.Body, "<script>") {
+				t.Errorf("Body contains unescaped user input: %s", res.Body)
+			}
+		})
+	}
+}
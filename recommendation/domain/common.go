@@ -0,0 +1,25 @@
+package domain
+
+// Candidate is a game with the signals it is recommended on.
+type Candidate struct {
+	GameID      int
+	TagIDs      []int
+	CategoryIDs []int
+	Plays       int
+	// CoPlays is the number of visitors who played both the candidate and
+	// the game recommendations are made for.
+	CoPlays int
+}
+
+// Frequencies are the number of published games in total and per tag and
+// category. Rare tags and categories say more about a game than common ones.
+type Frequencies struct {
+	Games      int
+	Tags       map[int]int
+	Categories map[int]int
+}
+
+type Recommendation struct {
+	GameID int
+	Score  float64
+}
@@ -0,0 +1,14 @@
+package domain
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrNoData       = Error("no data")
+	ErrInvalidID    = Error("invalid id")
+	ErrInvalidLimit = Error("invalid limit")
+	ErrInvalidData  = Error("invalid data")
+)
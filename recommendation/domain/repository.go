@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+)
+
+type Repository interface {
+	FindCandidates(context.Context, FindCandidatesQuery) (FindCandidatesResult, error)
+	FindFrequencies(context.Context) (FindFrequenciesResult, error)
+}
+
+// FindCandidatesQuery finds the published games that share a tag or a
+// category with the game, or were played by the same visitors.
+type FindCandidatesQuery struct {
+	ID int
+}
+
+type FindCandidatesResult struct {
+	Source Candidate
+	Data   []Candidate
+}
+
+type FindFrequenciesResult struct {
+	Data Frequencies
+}
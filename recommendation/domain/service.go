@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vediagames/zeroerror"
+
+	gamedomain "github.com/vediagames/platform/game/domain"
+)
+
+// MaxLimit is the most games returned for one game.
+const MaxLimit = 50
+
+type Service interface {
+	Similar(context.Context, SimilarRequest) (SimilarResponse, error)
+}
+
+type SimilarRequest struct {
+	ID       int
+	Language gamedomain.Language
+	Limit    int
+}
+
+func (r SimilarRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID < 1, ErrInvalidID)
+	err.AddIf(r.Limit < 1 || r.Limit > MaxLimit, ErrInvalidLimit)
+
+	if ve := r.Language.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid language: %w", ve))
+	}
+
+	return err.Err()
+}
+
+// SimilarResponse holds the games ranked from most to least similar.
+type SimilarResponse struct {
+	Data gamedomain.Games
+}
+
+func (r SimilarResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
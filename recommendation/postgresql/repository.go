@@ -0,0 +1,193 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/recommendation/domain"
+)
+
+type Config struct {
+	DB *sqlx.DB
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.DB == nil, fmt.Errorf("empty DB"))
+
+	if pingErr := c.DB.Ping(); pingErr != nil {
+		err.Add(fmt.Errorf("failed to ping: %w", pingErr))
+	}
+
+	return err.Err()
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+func New(cfg Config) domain.Repository {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &repository{
+		db: cfg.DB,
+	}
+}
+
+type candidate struct {
+	ID          int           `db:"id"`
+	Plays       int           `db:"plays"`
+	TagIDs      pq.Int32Array `db:"tag_ids"`
+	CategoryIDs pq.Int32Array `db:"category_ids"`
+	CoPlays     int           `db:"co_plays"`
+}
+
+func (c candidate) toDomain() domain.Candidate {
+	return domain.Candidate{
+		GameID:      c.ID,
+		TagIDs:      int32ArrayToInts(c.TagIDs),
+		CategoryIDs: int32ArrayToInts(c.CategoryIDs),
+		Plays:       c.Plays,
+		CoPlays:     c.CoPlays,
+	}
+}
+
+func (r repository) FindCandidates(ctx context.Context, q domain.FindCandidatesQuery) (domain.FindCandidatesResult, error) {
+	var source candidate
+
+	err := r.db.GetContext(ctx, &source, `
+		SELECT
+			g.id,
+			g.plays,
+			ARRAY(SELECT tag_id FROM public.game_tags WHERE game_id = g.id) AS tag_ids,
+			ARRAY(SELECT category_id FROM public.game_categories WHERE game_id = g.id) AS category_ids,
+			0 AS co_plays
+		FROM public.games g
+		WHERE g.id = $1
+	`, q.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.FindCandidatesResult{}, domain.ErrNoData
+	}
+	if err != nil {
+		return domain.FindCandidatesResult{}, fmt.Errorf("failed to get source: %w", err)
+	}
+
+	var candidates []candidate
+
+	// Co-plays are counted from the play history, play events do not say
+	// who played.
+	err = r.db.SelectContext(ctx, &candidates, `
+		WITH co_plays AS (
+			SELECT other.game_id, COUNT(*) AS co_plays
+			FROM public.game_play_history played
+				JOIN public.game_play_history other
+					ON other.visitor = played.visitor AND other.game_id != played.game_id
+			WHERE played.game_id = $1
+			GROUP BY other.game_id
+		)
+		SELECT
+			g.id,
+			g.plays,
+			ARRAY(SELECT tag_id FROM public.game_tags WHERE game_id = g.id) AS tag_ids,
+			ARRAY(SELECT category_id FROM public.game_categories WHERE game_id = g.id) AS category_ids,
+			COALESCE(c.co_plays, 0) AS co_plays
+		FROM public.games g
+			LEFT JOIN co_plays c ON c.game_id = g.id
+		WHERE g.status = 'published'
+			AND g.id != $1
+			AND (
+				c.game_id IS NOT NULL
+				OR EXISTS (
+					SELECT 1
+					FROM public.game_tags t
+					WHERE t.game_id = g.id AND t.tag_id = ANY($2)
+				)
+				OR EXISTS (
+					SELECT 1
+					FROM public.game_categories gc
+					WHERE gc.game_id = g.id AND gc.category_id = ANY($3)
+				)
+			)
+	`, q.ID, source.TagIDs, source.CategoryIDs)
+	if err != nil {
+		return domain.FindCandidatesResult{}, fmt.Errorf("failed to select candidates: %w", err)
+	}
+
+	res := domain.FindCandidatesResult{
+		Source: source.toDomain(),
+		Data:   make([]domain.Candidate, 0, len(candidates)),
+	}
+
+	for _, c := range candidates {
+		res.Data = append(res.Data, c.toDomain())
+	}
+
+	return res, nil
+}
+
+func (r repository) FindFrequencies(ctx context.Context) (domain.FindFrequenciesResult, error) {
+	res := domain.FindFrequenciesResult{
+		Data: domain.Frequencies{
+			Tags:       make(map[int]int),
+			Categories: make(map[int]int),
+		},
+	}
+
+	err := r.db.GetContext(ctx, &res.Data.Games, `
+		SELECT COUNT(*)
+		FROM public.games
+		WHERE status = 'published'
+	`)
+	if err != nil {
+		return domain.FindFrequenciesResult{}, fmt.Errorf("failed to count games: %w", err)
+	}
+
+	var rows []struct {
+		Kind  string `db:"kind"`
+		ID    int    `db:"id"`
+		Games int    `db:"games"`
+	}
+
+	err = r.db.SelectContext(ctx, &rows, `
+		SELECT 'tag' AS kind, t.tag_id AS id, COUNT(*) AS games
+		FROM public.game_tags t
+			JOIN public.games g ON g.id = t.game_id AND g.status = 'published'
+		GROUP BY t.tag_id
+		UNION ALL
+		SELECT 'category' AS kind, c.category_id AS id, COUNT(*) AS games
+		FROM public.game_categories c
+			JOIN public.games g ON g.id = c.game_id AND g.status = 'published'
+		GROUP BY c.category_id
+	`)
+	if err != nil {
+		return domain.FindFrequenciesResult{}, fmt.Errorf("failed to count games per tag and category: %w", err)
+	}
+
+	for _, row := range rows {
+		if row.Kind == "tag" {
+			res.Data.Tags[row.ID] = row.Games
+		} else {
+			res.Data.Categories[row.ID] = row.Games
+		}
+	}
+
+	return res, nil
+}
+
+func int32ArrayToInts(a pq.Int32Array) []int {
+	res := make([]int, len(a))
+	for i, v := range a {
+		res[i] = int(v)
+	}
+
+	return res
+}
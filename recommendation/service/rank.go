@@ -0,0 +1,111 @@
+package service
+
+import (
+	"math"
+	"sort"
+
+	"github.com/vediagames/platform/recommendation/domain"
+)
+
+type weights struct {
+	tag        float64
+	category   float64
+	coPlay     float64
+	popularity float64
+}
+
+// rank scores the candidates by three signals, each between 0 and 1:
+//   - content: the shared tags and categories, each weighted by how rare it
+//     is among published games, relative to those of the source
+//   - co-play: visitors who played both, relative to the most co-played
+//   - popularity: plays on a log scale, relative to the most played
+//
+// Popularity only breaks ties between related games, a candidate without
+// shared tags, categories or visitors is never ranked. Equal scores are
+// ordered by game ID, so the same data always gives the same ranking.
+func (w weights) rank(source domain.Candidate, candidates []domain.Candidate, freq domain.Frequencies) []domain.Recommendation {
+	idf := func(df int) float64 {
+		if df < 1 {
+			df = 1
+		}
+
+		return math.Log(1 + float64(freq.Games)/float64(df))
+	}
+
+	sourceTags := make(map[int]float64, len(source.TagIDs))
+	sourceCategories := make(map[int]float64, len(source.CategoryIDs))
+
+	var sourceContent float64
+
+	for _, id := range source.TagIDs {
+		sourceTags[id] = w.tag * idf(freq.Tags[id])
+		sourceContent += sourceTags[id]
+	}
+
+	for _, id := range source.CategoryIDs {
+		sourceCategories[id] = w.category * idf(freq.Categories[id])
+		sourceContent += sourceCategories[id]
+	}
+
+	var maxCoPlays, maxPlays int
+
+	for _, c := range candidates {
+		if c.CoPlays > maxCoPlays {
+			maxCoPlays = c.CoPlays
+		}
+
+		if c.Plays > maxPlays {
+			maxPlays = c.Plays
+		}
+	}
+
+	res := make([]domain.Recommendation, 0, len(candidates))
+
+	for _, c := range candidates {
+		if c.GameID == source.GameID {
+			continue
+		}
+
+		var content float64
+
+		for _, id := range c.TagIDs {
+			content += sourceTags[id]
+		}
+
+		for _, id := range c.CategoryIDs {
+			content += sourceCategories[id]
+		}
+
+		if content == 0 && c.CoPlays == 0 {
+			continue
+		}
+
+		score := content
+		if sourceContent > 0 {
+			score = content / sourceContent
+		}
+
+		if maxCoPlays > 0 {
+			score += w.coPlay * float64(c.CoPlays) / float64(maxCoPlays)
+		}
+
+		if maxPlays > 0 {
+			score += w.popularity * math.Log1p(float64(c.Plays)) / math.Log1p(float64(maxPlays))
+		}
+
+		res = append(res, domain.Recommendation{
+			GameID: c.GameID,
+			Score:  score,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+
+		return res[i].GameID < res[j].GameID
+	})
+
+	return res
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vediagames/platform/recommendation/domain"
+)
+
+func TestWeights_Rank(t *testing.T) {
+	w := weights{
+		tag:        1,
+		category:   0.5,
+		coPlay:     0.5,
+		popularity: 0.2,
+	}
+
+	// Tag 1 is on every game, tag 2 on few.
+	freq := domain.Frequencies{
+		Games:      100,
+		Tags:       map[int]int{1: 100, 2: 3},
+		Categories: map[int]int{10: 20},
+	}
+
+	source := domain.Candidate{GameID: 1, TagIDs: []int{1, 2}, CategoryIDs: []int{10}}
+
+	candidates := []domain.Candidate{
+		// Shares only the common tag, but is very popular.
+		{GameID: 2, TagIDs: []int{1}, Plays: 100000},
+		// Shares the rare tag.
+		{GameID: 3, TagIDs: []int{2}, Plays: 10},
+		// Shares the category and was played by the same visitors.
+		{GameID: 4, CategoryIDs: []int{10}, Plays: 10, CoPlays: 40},
+		// Shares nothing, popularity alone does not make it similar.
+		{GameID: 5, TagIDs: []int{3}, Plays: 100000},
+		// Equal to 3, the lower ID goes first.
+		{GameID: 6, TagIDs: []int{2}, Plays: 10},
+		// The source itself.
+		{GameID: 1, TagIDs: []int{1, 2}, CategoryIDs: []int{10}},
+	}
+
+	var got []int
+	for _, r := range w.rank(source, candidates, freq) {
+		got = append(got, r.GameID)
+	}
+
+	if want := []int{3, 6, 4, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("rank() = %v, want %v", got, want)
+	}
+}
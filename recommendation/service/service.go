@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vediagames/zeroerror"
+
+	gamedomain "github.com/vediagames/platform/game/domain"
+	"github.com/vediagames/platform/recommendation/domain"
+)
+
+type Config struct {
+	Repository  domain.Repository
+	GameService gamedomain.Service
+	// TagWeight and CategoryWeight weigh a shared tag against a shared
+	// category of the same rarity.
+	TagWeight      float64
+	CategoryWeight float64
+	// CoPlayWeight and PopularityWeight are the most a candidate gains from
+	// co-plays and plays, next to at most 1 from shared tags and categories.
+	CoPlayWeight     float64
+	PopularityWeight float64
+	// CacheTTL is how long the ranking of a game is kept.
+	CacheTTL time.Duration
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Repository == nil, fmt.Errorf("empty repository"))
+	err.AddIf(c.GameService == nil, fmt.Errorf("empty game service"))
+	err.AddIf(c.TagWeight < 0 || c.CategoryWeight < 0, fmt.Errorf("content weights must not be negative"))
+	err.AddIf(c.TagWeight == 0 && c.CategoryWeight == 0, fmt.Errorf("tag or category weight must be positive"))
+	err.AddIf(c.CoPlayWeight < 0, fmt.Errorf("co-play weight must not be negative"))
+	err.AddIf(c.PopularityWeight < 0, fmt.Errorf("popularity weight must not be negative"))
+	err.AddIf(c.CacheTTL <= 0, fmt.Errorf("cache TTL must be positive"))
+
+	return err.Err()
+}
+
+type service struct {
+	repository  domain.Repository
+	gameService gamedomain.Service
+	weights     weights
+	cacheTTL    time.Duration
+
+	mu    sync.Mutex
+	cache map[int]ranking
+}
+
+type ranking struct {
+	ids       []int
+	expiresAt time.Time
+}
+
+func New(cfg Config) domain.Service {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &service{
+		repository:  cfg.Repository,
+		gameService: cfg.GameService,
+		weights: weights{
+			tag:        cfg.TagWeight,
+			category:   cfg.CategoryWeight,
+			coPlay:     cfg.CoPlayWeight,
+			popularity: cfg.PopularityWeight,
+		},
+		cacheTTL: cfg.CacheTTL,
+		cache:    make(map[int]ranking),
+	}
+}
+
+// Similar returns the games most similar to the game. The ranking does not
+// depend on the language, it is shared by all languages while cached.
+func (s *service) Similar(ctx context.Context, req domain.SimilarRequest) (domain.SimilarResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.SimilarResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	ids, err := s.ranking(ctx, req.ID)
+	if err != nil {
+		return domain.SimilarResponse{}, fmt.Errorf("failed to rank: %w", err)
+	}
+
+	res := domain.SimilarResponse{
+		Data: gamedomain.Games{
+			Data: make([]gamedomain.Game, 0, req.Limit),
+		},
+	}
+
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	listRes, err := s.gameService.List(ctx, gamedomain.ListRequest{
+		Language: req.Language,
+		Page:     1,
+		Limit:    len(ids),
+		Sort:     gamedomain.SortingMethodID,
+		IDRefs:   ids,
+	})
+	if err != nil {
+		return domain.SimilarResponse{}, fmt.Errorf("failed to list games: %w", err)
+	}
+
+	games := make(map[int]gamedomain.Game, len(listRes.Data.Data))
+	for _, game := range listRes.Data.Data {
+		games[game.ID] = game
+	}
+
+	// Games set invisible since they were ranked are skipped.
+	for _, id := range ids {
+		game, ok := games[id]
+		if !ok {
+			continue
+		}
+
+		res.Data.Data = append(res.Data.Data, game)
+
+		if len(res.Data.Data) == req.Limit {
+			break
+		}
+	}
+
+	res.Data.Total = len(res.Data.Data)
+
+	if err := res.Validate(); err != nil {
+		return domain.SimilarResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+// ranking returns the IDs of the most similar games, MaxLimit at most.
+func (s *service) ranking(ctx context.Context, id int) ([]int, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[id]
+	s.mu.Unlock()
+
+	if ok && now.Before(cached.expiresAt) {
+		return cached.ids, nil
+	}
+
+	candidatesRes, err := s.repository.FindCandidates(ctx, domain.FindCandidatesQuery{
+		ID: id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find candidates: %w", err)
+	}
+
+	frequenciesRes, err := s.repository.FindFrequencies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find frequencies: %w", err)
+	}
+
+	recommendations := s.weights.rank(candidatesRes.Source, candidatesRes.Data, frequenciesRes.Data)
+
+	ids := make([]int, 0, domain.MaxLimit)
+	for _, r := range recommendations {
+		if len(ids) == domain.MaxLimit {
+			break
+		}
+
+		ids = append(ids, r.GameID)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Expired rankings are dropped here, so the cache never holds more
+	// than the games asked for within one TTL.
+	for cachedID, r := range s.cache {
+		if !now.Before(r.expiresAt) {
+			delete(s.cache, cachedID)
+		}
+	}
+
+	s.cache[id] = ranking{
+		ids:       ids,
+		expiresAt: now.Add(s.cacheTTL),
+	}
+
+	return ids, nil
+}
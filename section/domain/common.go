@@ -7,6 +7,21 @@ import (
 	"github.com/vediagames/zeroerror"
 )
 
+type Texts struct {
+	Name             string
+	ShortDescription string
+	Description      string
+	Content          string
+}
+
+func (t Texts) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(t.Name == "", ErrEmptyName)
+
+	return err.Err()
+}
+
 type Sections struct {
 	Data  []Section
 	Total int
@@ -28,4 +28,7 @@ const (
 	ErrInvalidPlacement       = Error("invalid placement")
 	ErrPlacementNotInOrder    = Error("placement not in order")
 	ErrInvalidData            = Error("invalid data")
+	ErrInvalidText            = Error("invalid text")
+	ErrMissingEnglishTexts    = Error("missing english texts")
+	ErrSlugAlreadyExists      = Error("slug already exists")
 )
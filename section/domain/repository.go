@@ -7,6 +7,9 @@ import (
 type Repository interface {
 	Find(context.Context, FindQuery) (FindResult, error)
 	FindOne(context.Context, FindOneQuery) (FindOneResult, error)
+	Insert(context.Context, InsertQuery) (InsertResult, error)
+	Update(context.Context, UpdateQuery) (UpdateResult, error)
+	Delete(context.Context, DeleteQuery) (DeleteResult, error)
 }
 
 type PlacedRepository interface {
@@ -14,10 +17,50 @@ type PlacedRepository interface {
 	Update(context.Context, PlacedUpdateQuery) error
 }
 
+type InsertQuery struct {
+	Slug           string
+	Status         Status
+	TagIDRefs      IDs
+	CategoryIDRefs IDs
+	GameIDRefs     IDs
+	Texts          map[Language]Texts
+}
+
+type InsertResult struct {
+	Data Section
+}
+
+type UpdateQuery struct {
+	ID             int
+	Slug           string
+	Status         Status
+	TagIDRefs      IDs
+	CategoryIDRefs IDs
+	GameIDRefs     IDs
+	Texts          map[Language]Texts
+}
+
+type UpdateResult struct {
+	Data Section
+}
+
+type DeleteQuery struct {
+	ID   int
+	Slug string
+}
+
+type DeleteResult struct {
+}
+
 type PlacedUpdateQuery struct {
 	Placements map[Placement]int
 }
 
+// SortedPlacements returns placements in ascending order.
+func (q PlacedUpdateQuery) SortedPlacements() []Placement {
+	return sortedPlacements(q.Placements)
+}
+
 type FindQuery struct {
 	Language       Language
 	Page           int
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/vediagames/zeroerror"
 )
@@ -10,6 +11,9 @@ import (
 type Service interface {
 	List(context.Context, ListRequest) (ListResponse, error)
 	Get(context.Context, GetRequest) (GetResponse, error)
+	Create(context.Context, CreateRequest) (CreateResponse, error)
+	Edit(context.Context, EditRequest) (EditResponse, error)
+	Remove(context.Context, RemoveRequest) (RemoveResponse, error)
 
 	GetPlaced(context.Context, GetPlacedRequest) (GetPlacedResponse, error)
 	EditPlaced(context.Context, EditPlacedRequest) error
@@ -26,7 +30,9 @@ func (r EditPlacedRequest) Validate() error {
 
 	previousPlacement := 0
 
-	for placement, sectionID := range r.Placements {
+	for _, placement := range r.SortedPlacements() {
+		sectionID := r.Placements[placement]
+
 		if int(placement) != previousPlacement+1 {
 			err.Add(fmt.Errorf("%w for placement %d and section %d", ErrPlacementNotInOrder, placement, sectionID))
 		}
@@ -45,6 +51,24 @@ func (r EditPlacedRequest) Validate() error {
 	return err.Err()
 }
 
+// SortedPlacements returns placements in ascending order.
+func (r EditPlacedRequest) SortedPlacements() []Placement {
+	return sortedPlacements(r.Placements)
+}
+
+func sortedPlacements(placements map[Placement]int) []Placement {
+	res := make([]Placement, 0, len(placements))
+	for placement := range placements {
+		res = append(res, placement)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+
+	return res
+}
+
 type GetPlacedRequest struct {
 	Language       Language
 	AllowDeleted   bool
@@ -154,3 +178,153 @@ func (r GetResponse) Validate() error {
 
 	return err.Err()
 }
+
+type CreateRequest struct {
+	Slug           string
+	Status         Status
+	TagIDRefs      IDs
+	CategoryIDRefs IDs
+	GameIDRefs     IDs
+	Texts          map[Language]Texts
+}
+
+func (r CreateRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Slug == "", ErrEmptySlug)
+
+	if ve := r.Status.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidStatus, ve))
+	}
+
+	if ve := r.TagIDRefs.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid tags: %w", ve))
+	}
+
+	if ve := r.CategoryIDRefs.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid categories: %w", ve))
+	}
+
+	if ve := r.GameIDRefs.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidGames, ve))
+	}
+
+	if ve := validateTexts(r.Texts); ve != nil {
+		err.Add(ve)
+	}
+
+	return err.Err()
+}
+
+type CreateResponse struct {
+	Data Section
+}
+
+func (r CreateResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+type EditRequest struct {
+	ID             int
+	Slug           string
+	Status         Status
+	TagIDRefs      IDs
+	CategoryIDRefs IDs
+	GameIDRefs     IDs
+	Texts          map[Language]Texts
+}
+
+func (r EditRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.ID <= 0, ErrInvalidID)
+	err.AddIf(r.Slug == "", ErrEmptySlug)
+
+	if ve := r.Status.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidStatus, ve))
+	}
+
+	if ve := r.TagIDRefs.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid tags: %w", ve))
+	}
+
+	if ve := r.CategoryIDRefs.Validate(); ve != nil {
+		err.Add(fmt.Errorf("invalid categories: %w", ve))
+	}
+
+	if ve := r.GameIDRefs.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidGames, ve))
+	}
+
+	if ve := validateTexts(r.Texts); ve != nil {
+		err.Add(ve)
+	}
+
+	return err.Err()
+}
+
+type EditResponse struct {
+	Data Section
+}
+
+func (r EditResponse) Validate() error {
+	var err zeroerror.Error
+
+	if ve := r.Data.Validate(); ve != nil {
+		err.Add(fmt.Errorf("%w: %w", ErrInvalidData, ve))
+	}
+
+	return err.Err()
+}
+
+type RemoveRequest struct {
+	ID   int
+	Slug string
+}
+
+func (r RemoveRequest) Validate() error {
+	var err zeroerror.Error
+
+	if r.ID <= 0 && r.Slug == "" {
+		err.Add(fmt.Errorf("id and slug are both empty"))
+	}
+
+	return err.Err()
+}
+
+type RemoveResponse struct {
+}
+
+func (r RemoveResponse) Validate() error {
+	var err zeroerror.Error
+
+	return err.Err()
+}
+
+// validateTexts requires English texts, since sections are returned in
+// English after being created or edited.
+func validateTexts(texts map[Language]Texts) error {
+	var err zeroerror.Error
+
+	if _, ok := texts[LanguageEnglish]; !ok {
+		err.Add(ErrMissingEnglishTexts)
+	}
+
+	for l, t := range texts {
+		if ve := l.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w: %w", ErrInvalidLanguage, ve))
+		}
+
+		if ve := t.Validate(); ve != nil {
+			err.Add(fmt.Errorf("%w at language %q: %w", ErrInvalidText, l, ve))
+		}
+	}
+
+	return err.Err()
+}
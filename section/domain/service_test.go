@@ -31,6 +31,30 @@ func TestEditWebsitePlacementsRequest_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "starts at zero",
+			req: EditPlacedRequest{
+				Placements: map[Placement]int{
+					0: 1,
+					1: 2,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid section",
+			req: EditPlacedRequest{
+				Placements: map[Placement]int{
+					1: 1,
+					2: 0,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty",
+			req:  EditPlacedRequest{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -44,3 +68,68 @@ func TestEditWebsitePlacementsRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     CreateRequest
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			req: CreateRequest{
+				Slug:       "action",
+				Status:     StatusPublished,
+				GameIDRefs: IDs{1, 2},
+				Texts: map[Language]Texts{
+					LanguageEnglish: {Name: "Action"},
+					LanguageEspanol: {Name: "Acción"},
+				},
+			},
+		},
+		{
+			name: "missing english texts",
+			req: CreateRequest{
+				Slug:   "action",
+				Status: StatusPublished,
+				Texts: map[Language]Texts{
+					LanguageEspanol: {Name: "Acción"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty name",
+			req: CreateRequest{
+				Slug:   "action",
+				Status: StatusInvisible,
+				Texts: map[Language]Texts{
+					LanguageEnglish: {},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid status",
+			req: CreateRequest{
+				Slug:   "action",
+				Status: "unknown",
+				Texts: map[Language]Texts{
+					LanguageEnglish: {Name: "Action"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if err != nil != tt.wantErr {
+				t.Errorf("CreateRequest.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			t.Log(err)
+		})
+	}
+}
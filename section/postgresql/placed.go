@@ -74,23 +74,23 @@ func (r placedRepository) Find(ctx context.Context, q domain.PlacedFindQuery) (d
 }
 
 func (r placedRepository) Update(ctx context.Context, q domain.PlacedUpdateQuery) error {
-	tx, err := r.db.Beginx()
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin tx: %w", err)
 	}
 
-	_, err = tx.Exec(`
+	_, err = tx.ExecContext(ctx, `
 		DELETE FROM public.website_sections_placement;
 	`)
 	if err != nil {
-		return txError(tx, fmt.Errorf("failed to insert: %w", err))
+		return txError(tx, fmt.Errorf("failed to delete: %w", err))
 	}
 
-	for placement, sectionID := range q.Placements {
-		_, err = tx.Exec(`
+	for _, placement := range q.SortedPlacements() {
+		_, err = tx.ExecContext(ctx, `
 			INSERT INTO public.website_sections_placement (section_id, placement_number)
 			VALUES ($1, $2);
-		`, sectionID, int(placement))
+		`, q.Placements[placement], int(placement))
 
 		if err != nil {
 			return txError(tx, fmt.Errorf("failed to insert: %w", err))
@@ -0,0 +1,227 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+
+	"github.com/vediagames/platform/section/domain"
+)
+
+var langIDMap = map[domain.Language]int{
+	domain.LanguageEnglish: 1,
+	domain.LanguageEspanol: 2,
+}
+
+func (r repository) Insert(ctx context.Context, q domain.InsertQuery) (domain.InsertResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	var sectionID int
+
+	err = tx.GetContext(ctx, &sectionID, `
+		INSERT INTO sections (
+			slug,
+			status,
+			published_at
+		)
+		VALUES (
+			$1,
+			$2,
+			CASE WHEN $2 = 'published' THEN NOW() END
+		)
+		RETURNING id;
+	`, q.Slug, q.Status.String())
+	if err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to insert: %w", err)
+	}
+
+	err = replaceRefs(ctx, tx, sectionID, q.TagIDRefs, q.CategoryIDRefs, q.GameIDRefs)
+	if err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to replace refs: %w", err)
+	}
+
+	if err = replaceTexts(ctx, tx, sectionID, q.Texts); err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to replace texts: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	repoRes, err := r.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    sectionID,
+		Language: domain.LanguageEnglish,
+	})
+	if err != nil {
+		return domain.InsertResult{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	return domain.InsertResult(repoRes), nil
+}
+
+func (r repository) Update(ctx context.Context, q domain.UpdateQuery) (domain.UpdateResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE sections
+		SET
+			slug = $1,
+			status = $2,
+			published_at = CASE WHEN $2 = 'published' THEN COALESCE(published_at, NOW()) ELSE published_at END
+		WHERE id = $3
+	`, q.Slug, q.Status.String(), q.ID)
+	if err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to update: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.UpdateResult{}, domain.ErrNoData
+	}
+
+	if err = replaceRefs(ctx, tx, q.ID, q.TagIDRefs, q.CategoryIDRefs, q.GameIDRefs); err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to replace refs: %w", err)
+	}
+
+	if err = replaceTexts(ctx, tx, q.ID, q.Texts); err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to replace texts: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	repoRes, err := r.FindOne(ctx, domain.FindOneQuery{
+		Field:    domain.GetByFieldID,
+		Value:    q.ID,
+		Language: domain.LanguageEnglish,
+	})
+	if err != nil {
+		return domain.UpdateResult{}, fmt.Errorf("failed to find one: %w", err)
+	}
+
+	return domain.UpdateResult(repoRes), nil
+}
+
+func (r repository) Delete(ctx context.Context, q domain.DeleteQuery) (domain.DeleteResult, error) {
+	var (
+		query string
+		arg   any
+	)
+
+	switch {
+	case q.ID != 0:
+		query = "UPDATE sections SET status = 'deleted', deleted_at = NOW() WHERE id = $1"
+		arg = q.ID
+	case q.Slug != "":
+		query = "UPDATE sections SET status = 'deleted', deleted_at = NOW() WHERE slug = $1"
+		arg = q.Slug
+	}
+
+	res, err := r.db.ExecContext(ctx, query, arg)
+	if err != nil {
+		return domain.DeleteResult{}, fmt.Errorf("failed to exec: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return domain.DeleteResult{}, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.DeleteResult{}, domain.ErrNoData
+	}
+
+	return domain.DeleteResult{}, nil
+}
+
+// replaceRefs replaces tag, category and game references of the section.
+func replaceRefs(ctx context.Context, tx *sqlx.Tx, sectionID int, tagIDs, categoryIDs, gameIDs domain.IDs) error {
+	refs := []struct {
+		table  string
+		column string
+		ids    domain.IDs
+	}{
+		{table: "section_tags", column: "tag_id", ids: tagIDs},
+		{table: "section_categories", column: "category_id", ids: categoryIDs},
+		{table: "section_games", column: "game_id", ids: gameIDs},
+	}
+
+	for _, ref := range refs {
+		_, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE section_id = $1", ref.table), sectionID)
+		if err != nil {
+			return fmt.Errorf("failed to delete from %s: %w", ref.table, err)
+		}
+
+		for i, id := range ref.ids {
+			_, err = tx.ExecContext(ctx,
+				fmt.Sprintf("INSERT INTO %s (section_id, %s) VALUES ($1, $2)", ref.table, ref.column),
+				sectionID, id,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to insert %s %d at index %d: %w", ref.column, id, i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// replaceTexts replaces the texts of the given languages, texts of other
+// languages are left untouched.
+func replaceTexts(ctx context.Context, tx *sqlx.Tx, sectionID int, texts map[domain.Language]domain.Texts) error {
+	for lang, t := range texts {
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM section_texts WHERE section_id = $1 AND language_id = $2
+		`, sectionID, langIDMap[lang])
+		if err != nil {
+			return fmt.Errorf("failed to delete text for language %q: %w", lang, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO section_texts (
+				section_id,
+				language_id,
+				name,
+				short_description,
+				description,
+				content
+			)
+			VALUES (
+				$1,
+				$2,
+				$3,
+				$4,
+				$5,
+				$6
+			)
+		`, sectionID, langIDMap[lang], t.Name, t.ShortDescription, t.Description, t.Content)
+		if err != nil {
+			return fmt.Errorf("failed to insert text for language %q: %w", lang, err)
+		}
+	}
+
+	return nil
+}
+
+func rollback(ctx context.Context, tx *sqlx.Tx) {
+	if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		zerolog.Ctx(ctx).Error().Err(fmt.Errorf("failed to rollback: %w", err)).Send()
+	}
+}
@@ -73,3 +73,30 @@ func (s service) EditPlaced(ctx context.Context, req domain.EditPlacedRequest) e
 
 	return nil
 }
+
+func (s service) Create(ctx context.Context, req domain.CreateRequest) (domain.CreateResponse, error) {
+	repoRes, err := s.repository.Insert(ctx, domain.InsertQuery(req))
+	if err != nil {
+		return domain.CreateResponse{}, fmt.Errorf("failed to insert section: %w", err)
+	}
+
+	return domain.CreateResponse(repoRes), nil
+}
+
+func (s service) Edit(ctx context.Context, req domain.EditRequest) (domain.EditResponse, error) {
+	repoRes, err := s.repository.Update(ctx, domain.UpdateQuery(req))
+	if err != nil {
+		return domain.EditResponse{}, fmt.Errorf("failed to update section: %w", err)
+	}
+
+	return domain.EditResponse(repoRes), nil
+}
+
+func (s service) Remove(ctx context.Context, req domain.RemoveRequest) (domain.RemoveResponse, error) {
+	repoRes, err := s.repository.Delete(ctx, domain.DeleteQuery(req))
+	if err != nil {
+		return domain.RemoveResponse{}, fmt.Errorf("failed to delete section: %w", err)
+	}
+
+	return domain.RemoveResponse(repoRes), nil
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/vediagames/platform/section/domain"
@@ -34,7 +35,9 @@ func (s service) GetPlaced(ctx context.Context, req domain.GetPlacedRequest) (do
 }
 
 func (s service) EditPlaced(ctx context.Context, req domain.EditPlacedRequest) error {
-	for placement, sectionID := range req.Placements {
+	for _, placement := range req.SortedPlacements() {
+		sectionID := req.Placements[placement]
+
 		_, err := s.svc.Get(ctx, domain.GetRequest{
 			Field:    domain.GetByFieldID,
 			Value:    sectionID,
@@ -47,3 +50,63 @@ func (s service) EditPlaced(ctx context.Context, req domain.EditPlacedRequest) e
 
 	return s.svc.EditPlaced(ctx, req)
 }
+
+func (s service) Create(ctx context.Context, req domain.CreateRequest) (domain.CreateResponse, error) {
+	_, err := s.svc.Get(ctx, domain.GetRequest{
+		Field:    domain.GetByFieldSlug,
+		Value:    req.Slug,
+		Language: domain.LanguageEnglish,
+	})
+	switch {
+	case err == nil:
+		return domain.CreateResponse{}, fmt.Errorf("%w: %q", domain.ErrSlugAlreadyExists, req.Slug)
+	case !errors.Is(err, domain.ErrNoData):
+		return domain.CreateResponse{}, fmt.Errorf("failed to get section with slug %q: %w", req.Slug, err)
+	}
+
+	return s.svc.Create(ctx, req)
+}
+
+func (s service) Edit(ctx context.Context, req domain.EditRequest) (domain.EditResponse, error) {
+	_, err := s.svc.Get(ctx, domain.GetRequest{
+		Field:    domain.GetByFieldID,
+		Value:    req.ID,
+		Language: domain.LanguageEnglish,
+	})
+	if err != nil {
+		return domain.EditResponse{}, fmt.Errorf("failed to get section with id %d: %w", req.ID, err)
+	}
+
+	res, err := s.svc.Get(ctx, domain.GetRequest{
+		Field:    domain.GetByFieldSlug,
+		Value:    req.Slug,
+		Language: domain.LanguageEnglish,
+	})
+	switch {
+	case err == nil && res.Data.ID != req.ID:
+		return domain.EditResponse{}, fmt.Errorf("%w: %q", domain.ErrSlugAlreadyExists, req.Slug)
+	case err != nil && !errors.Is(err, domain.ErrNoData):
+		return domain.EditResponse{}, fmt.Errorf("failed to get section with slug %q: %w", req.Slug, err)
+	}
+
+	return s.svc.Edit(ctx, req)
+}
+
+func (s service) Remove(ctx context.Context, req domain.RemoveRequest) (domain.RemoveResponse, error) {
+	getReq := domain.GetRequest{
+		Field:    domain.GetByFieldID,
+		Value:    req.ID,
+		Language: domain.LanguageEnglish,
+	}
+
+	if req.ID <= 0 {
+		getReq.Field = domain.GetByFieldSlug
+		getReq.Value = req.Slug
+	}
+
+	if _, err := s.svc.Get(ctx, getReq); err != nil {
+		return domain.RemoveResponse{}, fmt.Errorf("failed to get section by %s %v: %w", getReq.Field, getReq.Value, err)
+	}
+
+	return s.svc.Remove(ctx, req)
+}
@@ -83,3 +83,54 @@ func (s service) EditPlaced(ctx context.Context, req domain.EditPlacedRequest) e
 
 	return nil
 }
+
+func (s service) Create(ctx context.Context, req domain.CreateRequest) (domain.CreateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.CreateResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	res, err := s.svc.Create(ctx, req)
+	if err != nil {
+		return domain.CreateResponse{}, fmt.Errorf("failed to create section: %w", err)
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.CreateResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) Edit(ctx context.Context, req domain.EditRequest) (domain.EditResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.EditResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	res, err := s.svc.Edit(ctx, req)
+	if err != nil {
+		return domain.EditResponse{}, fmt.Errorf("failed to edit section: %w", err)
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.EditResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s service) Remove(ctx context.Context, req domain.RemoveRequest) (domain.RemoveResponse, error) {
+	if err := req.Validate(); err != nil {
+		return domain.RemoveResponse{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	res, err := s.svc.Remove(ctx, req)
+	if err != nil {
+		return domain.RemoveResponse{}, fmt.Errorf("failed to remove section: %w", err)
+	}
+
+	if err := res.Validate(); err != nil {
+		return domain.RemoveResponse{}, fmt.Errorf("invalid response: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+type contextKey string
+
+const idContextKey contextKey = "session_id"
+
+// IDToContext stores the ID of the session the request was made in.
+func IDToContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, idContextKey, id)
+}
+
+// IDFromContext returns the ID set by IDToContext, or an empty string.
+func IDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(idContextKey).(string)
+	return id
+}
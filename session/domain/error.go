@@ -12,4 +12,6 @@ const (
 	ErrInvalidCreatedAt  = Error("invalid created at")
 	ErrInvalidInsertedAt = Error("invalid inserted at")
 	ErrEmptyPageURL      = Error("empty page URL")
+	ErrEmptyToken        = Error("empty token")
+	ErrInvalidToken      = Error("invalid token")
 )
@@ -10,6 +10,7 @@ import (
 
 type Service interface {
 	Create(context.Context, CreateRequest) (CreateResponse, error)
+	Verify(context.Context, VerifyRequest) (VerifyResponse, error)
 }
 
 type CreateRequest struct {
@@ -38,6 +39,8 @@ func (r CreateRequest) Validate() error {
 
 type CreateResponse struct {
 	Session Session
+	// Token is the signed session ID the client sends back, see Verify.
+	Token string
 }
 
 func (r CreateResponse) Validate() error {
@@ -46,6 +49,23 @@ func (r CreateResponse) Validate() error {
 	err.AddIf(r.Session.ID == "", ErrEmptyID)
 	err.AddIf(r.Session.CreatedAt.IsZero(), ErrInvalidCreatedAt)
 	err.AddIf(r.Session.InsertedAt.IsZero(), ErrInvalidInsertedAt)
+	err.AddIf(r.Token == "", ErrEmptyToken)
 
 	return err.Err()
 }
+
+type VerifyRequest struct {
+	Token string
+}
+
+func (r VerifyRequest) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(r.Token == "", ErrEmptyToken)
+
+	return err.Err()
+}
+
+type VerifyResponse struct {
+	ID string
+}
@@ -12,6 +12,7 @@ import (
 
 type CreateResponse struct {
 	ID         string    `json:"id"`
+	Token      string    `json:"token"`
 	IP         string    `json:"ip"`
 	Device     string    `json:"device"`
 	PageURL    string    `json:"page_url"`
@@ -57,6 +58,7 @@ func CreateHandler(s domain.Service) http.HandlerFunc {
 
 		jsonRes, err := json.Marshal(CreateResponse{
 			ID:         res.Session.ID,
+			Token:      res.Token,
 			IP:         res.Session.IP.String(),
 			Device:     res.Session.Device.String(),
 			PageURL:    res.Session.PageURL,
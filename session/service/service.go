@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/vediagames/zeroerror"
 
@@ -11,10 +15,13 @@ import (
 
 type service struct {
 	repository domain.Repository
+	key        []byte
 }
 
 type Config struct {
 	Repository domain.Repository
+	// Key signs the session IDs handed out by Create.
+	Key string
 }
 
 func (c Config) Validate() error {
@@ -24,6 +31,8 @@ func (c Config) Validate() error {
 		err.AddIf(c.Repository == nil, fmt.Errorf("repository is required"))
 	}
 
+	err.AddIf(c.Key == "", fmt.Errorf("key is required"))
+
 	return err.Err()
 }
 
@@ -34,6 +43,7 @@ func New(cfg Config) domain.Service {
 
 	return &service{
 		repository: cfg.Repository,
+		key:        []byte(cfg.Key),
 	}
 }
 
@@ -47,10 +57,40 @@ func (s service) Create(ctx context.Context, req domain.CreateRequest) (domain.C
 		return domain.CreateResponse{}, fmt.Errorf("failed to insert: %w", err)
 	}
 
-	res := domain.CreateResponse(repoRes)
+	res := domain.CreateResponse{
+		Session: repoRes.Session,
+		Token:   repoRes.Session.ID + "." + s.sign(repoRes.Session.ID),
+	}
 	if err := res.Validate(); err != nil {
 		return domain.CreateResponse{}, fmt.Errorf("invalid response: %w", err)
 	}
 
 	return res, nil
 }
+
+// Verify checks that the token was handed out by Create, so that a client
+// cannot make up session IDs, and returns the session ID it carries.
+func (s service) Verify(ctx context.Context, req domain.VerifyRequest) (domain.VerifyResponse, error) {
+	if ve := req.Validate(); ve != nil {
+		return domain.VerifyResponse{}, fmt.Errorf("invalid request: %w", ve)
+	}
+
+	id, signature, ok := strings.Cut(req.Token, ".")
+	if !ok || id == "" {
+		return domain.VerifyResponse{}, fmt.Errorf("%w: missing signature", domain.ErrInvalidToken)
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.sign(id))) {
+		return domain.VerifyResponse{}, fmt.Errorf("%w: signature mismatch", domain.ErrInvalidToken)
+	}
+
+	return domain.VerifyResponse{
+		ID: id,
+	}, nil
+}
+
+func (s service) sign(id string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(id))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
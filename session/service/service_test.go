@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vediagames/platform/session/domain"
+)
+
+type fakeRepository struct {
+	domain.Repository
+}
+
+func (fakeRepository) Insert(_ context.Context, q domain.InsertQuery) (domain.InsertResult, error) {
+	return domain.InsertResult{
+		Session: domain.Session{
+			ID:         "5f0c6a1e-3b8e-4d56-9a3f-2f1d0c7b9e41",
+			PageURL:    q.PageURL,
+			IP:         q.IP,
+			Device:     q.Device,
+			CreatedAt:  q.CreatedAt,
+			InsertedAt: q.CreatedAt,
+		},
+	}, nil
+}
+
+func TestService_Verify(t *testing.T) {
+	s := New(Config{
+		Repository: fakeRepository{},
+		Key:        "secret",
+	})
+
+	createRes, err := s.Create(context.Background(), domain.CreateRequest{
+		PageURL:   "https://vediagames.com",
+		IP:        "127.0.0.1",
+		Device:    domain.DeviceDesktop,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	other := New(Config{
+		Repository: fakeRepository{},
+		Key:        "other",
+	})
+
+	otherRes, err := other.Create(context.Background(), domain.CreateRequest{
+		PageURL:   "https://vediagames.com",
+		IP:        "127.0.0.1",
+		Device:    domain.DeviceDesktop,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "created", token: createRes.Token},
+		{name: "bare ID", token: createRes.Session.ID, wantErr: domain.ErrInvalidToken},
+		{name: "other key", token: otherRes.Token, wantErr: domain.ErrInvalidToken},
+		{name: "other ID", token: "0" + createRes.Token[1:], wantErr: domain.ErrInvalidToken},
+		{name: "empty ID", token: createRes.Token[len(createRes.Session.ID):], wantErr: domain.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.Verify(context.Background(), domain.VerifyRequest{
+				Token: tt.token,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && res.ID != createRes.Session.ID {
+				t.Errorf("Verify() ID = %q, want %q", res.ID, createRes.Session.ID)
+			}
+		})
+	}
+}
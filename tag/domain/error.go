@@ -7,23 +7,27 @@ func (e Error) Error() string {
 }
 
 const (
-	ErrInvalidPage      = Error("invalid page")
-	ErrInvalidLimit     = Error("invalid limit")
-	ErrInvalidLanguage  = Error("invalid language")
-	ErrInvalidField     = Error("invalid field")
-	ErrEmptyValue       = Error("empty value")
-	ErrEmptySlug        = Error("empty slug")
-	ErrEmptyName        = Error("empty name")
-	ErrInvalidID        = Error("invalid id")
-	ErrInvalidStatus    = Error("invalid status")
-	ErrInvalidClicks    = Error("invalid clicks")
-	ErrInvalidTag       = Error("invalid tag")
-	ErrInvalidAmount    = Error("invalid amount")
-	ErrInvalidCreatedAt = Error("invalid created at")
-	ErrInvalidTotal     = Error("invalid total")
-	ErrNoData           = Error("no data")
-	ErrInvalidMax       = Error("invalid max")
-	ErrQueryTooShort    = Error("query too short")
-	ErrInvalidData      = Error("invalid data")
-	ErrInvalidIDRefs    = Error("invalid ID refs")
+	ErrInvalidPage       = Error("invalid page")
+	ErrInvalidLimit      = Error("invalid limit")
+	ErrInvalidLanguage   = Error("invalid language")
+	ErrInvalidField      = Error("invalid field")
+	ErrEmptyValue        = Error("empty value")
+	ErrEmptySlug         = Error("empty slug")
+	ErrEmptyName         = Error("empty name")
+	ErrInvalidID         = Error("invalid id")
+	ErrInvalidStatus     = Error("invalid status")
+	ErrInvalidClicks     = Error("invalid clicks")
+	ErrInvalidTag        = Error("invalid tag")
+	ErrInvalidAmount     = Error("invalid amount")
+	ErrInvalidCreatedAt  = Error("invalid created at")
+	ErrInvalidTotal      = Error("invalid total")
+	ErrNoData            = Error("no data")
+	ErrInvalidMax        = Error("invalid max")
+	ErrQueryTooShort     = Error("query too short")
+	ErrInvalidData       = Error("invalid data")
+	ErrInvalidIDRefs     = Error("invalid ID refs")
+	ErrInvalidText       = Error("invalid text")
+	ErrReferencedByGames = Error("referenced by games")
+	ErrInvalidCursor     = Error("invalid cursor")
+	ErrInvalidFirst      = Error("invalid first")
 )
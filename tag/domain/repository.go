@@ -8,8 +8,12 @@ type Repository interface {
 	Find(context.Context, FindQuery) (FindResult, error)
 	FindOne(context.Context, FindOneQuery) (FindOneResult, error)
 	IncreaseField(context.Context, IncreaseFieldQuery) error
+	Insert(context.Context, InsertQuery) (InsertResult, error)
+	Update(context.Context, UpdateQuery) (UpdateResult, error)
+	Delete(context.Context, DeleteQuery) (DeleteResult, error)
 	Search(context.Context, SearchQuery) (SearchResult, error)
 	FullSearch(context.Context, FullSearchQuery) (FullSearchResult, error)
+	FindSlugRedirect(context.Context, FindSlugRedirectQuery) (FindSlugRedirectResult, error)
 }
 
 type SearchQuery struct {
@@ -46,10 +50,15 @@ type FindQuery struct {
 	AllowInvisible bool
 	Sort           SortingMethod
 	IDRefs         IDs
+	// First is set instead of Page and Limit to page by cursor.
+	First int
+	After Cursor
 }
 
 type FindResult struct {
-	Data Tags
+	Data        Tags
+	Cursors     []Cursor
+	HasNextPage bool
 }
 
 type FindOneQuery struct {
@@ -67,3 +76,41 @@ type IncreaseFieldQuery struct {
 	Field    IncreasableField
 	ByAmount int
 }
+
+type InsertQuery struct {
+	Slug   string
+	Status Status
+	Texts  map[Language]Texts
+}
+
+type InsertResult struct {
+	Data Tag
+}
+
+type UpdateQuery struct {
+	ID     int
+	Slug   string
+	Status Status
+	Texts  map[Language]Texts
+}
+
+type UpdateResult struct {
+	Data Tag
+}
+
+type DeleteQuery struct {
+	ID   int
+	Slug string
+}
+
+type DeleteResult struct {
+}
+
+// FindSlugRedirectQuery finds the tag that had Slug before it was renamed.
+type FindSlugRedirectQuery struct {
+	Slug string
+}
+
+type FindSlugRedirectResult struct {
+	ID int
+}
@@ -95,9 +95,53 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 		zerolog.Ctx(ctx).Warn().Str("sort", q.Sort.String()).Msg("unsupported sorting method")
 	}
 
+	ks, isKeyset := keysetOptions[q.Sort]
+	isKeyset = isKeyset && q.First > 0
+
+	if q.First > 0 && !isKeyset {
+		return domain.FindResult{}, fmt.Errorf("sorting method %s is not supported with a cursor", q.Sort)
+	}
+
+	args := map[string]interface{}{
+		"language_code": q.Language.String(),
+		"limit":         q.Limit,
+		"offset":        (q.Page - 1) * q.Limit,
+		"id_refs":       q.IDRefs,
+	}
+
+	seed := q.After.Seed
+
+	if isKeyset {
+		if q.Sort == domain.SortingMethodRandom && seed == "" {
+			if !q.After.IsZero() {
+				return domain.FindResult{}, fmt.Errorf("%w: random order without seed", domain.ErrInvalidCursor)
+			}
+
+			seed = newSeed()
+		}
+
+		keysetArgs, err := ks.args(q.After)
+		if err != nil {
+			return domain.FindResult{}, err
+		}
+
+		for k, v := range keysetArgs {
+			args[k] = v
+		}
+
+		val = ks.orderBy()
+		args["seed"] = seed
+		// One more than asked tells if there is a next page.
+		args["limit"] = q.First + 1
+	}
+
 	sqlQuery, err := templateToSQL(
 		"find_tags",
 		templateQuery{
+			"Keyset":         isKeyset,
+			"SortKeys":       ks.sortKeys(),
+			"After":          !q.After.IsZero(),
+			"AfterCursor":    ks.after(),
 			"AllowDeleted":   q.AllowDeleted,
 			"AllowInvisible": q.AllowInvisible,
 			"FilterByIDRefs": len(q.IDRefs) > 0,
@@ -118,7 +162,11 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 				created_at,
 				deleted_at,
 				published_at,
+			{{ if .Keyset }}
+				{{ .SortKeys }} AS sort_keys
+			{{ else }}
 				COUNT(*) OVER() AS total_count
+			{{ end }}
 			FROM public.tags_view
 			WHERE language_code = :language_code
 			{{ if not .AllowDeleted }}
@@ -130,27 +178,27 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 			{{ if .FilterByIDRefs }}
 				AND id IN (:id_refs)
 			{{ end }}
+			{{ if and .Keyset .After }}
+				AND {{ .AfterCursor }}
+			{{ end }}
 			{{ if .ShouldOrderBy }}
 			ORDER BY {{ .OrderBy }}
 			{{ end }}
 			LIMIT :limit
-			OFFSET :offset;
+			{{ if not .Keyset }}
+			OFFSET :offset
+			{{ end }};
 	`)
 	if err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to create SQL from template: %w", err)
 	}
 
-	query, args, err := sqlx.Named(sqlQuery, map[string]interface{}{
-		"language_code": q.Language.String(),
-		"limit":         q.Limit,
-		"offset":        (q.Page - 1) * q.Limit,
-		"id_refs":       q.IDRefs,
-	})
+	query, queryArgs, err := sqlx.Named(sqlQuery, args)
 	if err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to generate named: %w", err)
 	}
 
-	query, args, err = sqlx.In(query, args...)
+	query, queryArgs, err = sqlx.In(query, queryArgs...)
 	if err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to expand %w", err)
 	}
@@ -159,7 +207,7 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 
 	var sqlRes []tagWithTotalCount
 
-	if err := r.db.Select(&sqlRes, query, args...); err != nil {
+	if err := r.db.Select(&sqlRes, query, queryArgs...); err != nil {
 		return domain.FindResult{}, fmt.Errorf("failed to select: %w", err)
 	}
 
@@ -170,11 +218,25 @@ func (r repository) Find(ctx context.Context, q domain.FindQuery) (domain.FindRe
 		},
 	}
 
+	if isKeyset && len(sqlRes) > q.First {
+		sqlRes = sqlRes[:q.First]
+		res.HasNextPage = true
+	}
+
 	if len(sqlRes) > 0 {
 		res.Data.Total = sqlRes[0].TotalCount
 
 		for _, tag := range sqlRes {
 			res.Data.Data = append(res.Data.Data, tag.toDomain())
+
+			if isKeyset {
+				res.Cursors = append(res.Cursors, domain.Cursor{
+					Sort: q.Sort,
+					Keys: tag.SortKeys,
+					ID:   tag.ID,
+					Seed: seed,
+				})
+			}
 		}
 	}
 
@@ -279,6 +341,8 @@ func templateToSQL(name string, tq templateQuery, tmpl string) (string, error) {
 type tagWithTotalCount struct {
 	tag
 	TotalCount int `db:"total_count"`
+	// SortKeys are only selected when paging by cursor.
+	SortKeys pq.StringArray `db:"sort_keys"`
 }
 
 type templateQuery map[string]any
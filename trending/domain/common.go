@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vediagames/zeroerror"
+)
+
+// Score ranks a game by how fast it is played compared to its baseline.
+type Score struct {
+	GameID int
+	Score  float64
+	// Plays are the plays within the trending window.
+	Plays int
+}
+
+func (s Score) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(s.GameID < 1, fmt.Errorf("invalid game ID"))
+	err.AddIf(s.Score < 0, fmt.Errorf("negative score"))
+	err.AddIf(s.Plays < 0, fmt.Errorf("negative plays"))
+
+	return err.Err()
+}
+
+// PlayCount is the number of plays of a game within one bucket.
+type PlayCount struct {
+	GameID int
+	Bucket time.Time
+	Plays  int
+}
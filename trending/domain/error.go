@@ -0,0 +1,11 @@
+package domain
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrInvalidData = Error("invalid data")
+)
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	FindPlayCounts(context.Context, FindPlayCountsQuery) (FindPlayCountsResult, error)
+	ReplaceScores(context.Context, ReplaceScoresQuery) error
+}
+
+// FindPlayCountsQuery counts the plays since Since in hourly buckets.
+type FindPlayCountsQuery struct {
+	Since time.Time
+}
+
+type FindPlayCountsResult struct {
+	Data []PlayCount
+}
+
+// ReplaceScoresQuery replaces the whole snapshot, games left out are no
+// longer trending.
+type ReplaceScoresQuery struct {
+	Data       []Score
+	ComputedAt time.Time
+}
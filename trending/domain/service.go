@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+)
+
+type Service interface {
+	Compute(context.Context) (ComputeResponse, error)
+}
+
+type ComputeResponse struct {
+	// Scored is the number of games in the snapshot.
+	Scored int
+}
@@ -0,0 +1,128 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/trending/domain"
+)
+
+type Config struct {
+	DB *sqlx.DB
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.DB == nil, fmt.Errorf("empty DB"))
+
+	if pingErr := c.DB.Ping(); pingErr != nil {
+		err.Add(fmt.Errorf("failed to ping: %w", pingErr))
+	}
+
+	return err.Err()
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+func New(cfg Config) domain.Repository {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &repository{
+		db: cfg.DB,
+	}
+}
+
+// FindPlayCounts reads game_play_events, whose dates are stored in UTC
+// without a time zone.
+func (r repository) FindPlayCounts(ctx context.Context, q domain.FindPlayCountsQuery) (domain.FindPlayCountsResult, error) {
+	var rows []struct {
+		GameID int       `db:"game_id"`
+		Bucket time.Time `db:"bucket"`
+		Plays  int       `db:"plays"`
+	}
+
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT game_id, date_trunc('hour', date) AS bucket, COUNT(*) AS plays
+		FROM public.game_play_events
+		WHERE date > $1
+		GROUP BY game_id, bucket
+	`, q.Since.UTC())
+	if err != nil {
+		return domain.FindPlayCountsResult{}, fmt.Errorf("failed to select: %w", err)
+	}
+
+	res := domain.FindPlayCountsResult{
+		Data: make([]domain.PlayCount, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Data = append(res.Data, domain.PlayCount{
+			GameID: row.GameID,
+			Bucket: time.Date(
+				row.Bucket.Year(), row.Bucket.Month(), row.Bucket.Day(),
+				row.Bucket.Hour(), 0, 0, 0, time.UTC,
+			),
+			Plays: row.Plays,
+		})
+	}
+
+	return res, nil
+}
+
+func (r repository) ReplaceScores(ctx context.Context, q domain.ReplaceScoresQuery) error {
+	ids := make([]int64, 0, len(q.Data))
+	scores := make([]float64, 0, len(q.Data))
+	plays := make([]int64, 0, len(q.Data))
+
+	for _, s := range q.Data {
+		ids = append(ids, int64(s.GameID))
+		scores = append(scores, s.Score)
+		plays = append(plays, int64(s.Plays))
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM public.game_trending_scores`); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	// Games deleted since their plays were counted are skipped by the join.
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO public.game_trending_scores (game_id, score, plays, computed_at)
+		SELECT s.game_id, s.score, s.plays, $4
+		FROM unnest($1::int[], $2::float8[], $3::int[]) AS s (game_id, score, plays)
+			JOIN public.games g ON g.id = s.game_id
+	`, pq.Array(ids), pq.Array(scores), pq.Array(plays), q.ComputedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return nil
+}
+
+func rollback(ctx context.Context, tx *sqlx.Tx) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		zerolog.Ctx(ctx).Error().Err(fmt.Errorf("failed to rollback: %w", err)).Send()
+	}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/vediagames/platform/trending/domain"
+)
+
+type scoring struct {
+	window         time.Duration
+	baselineWindow time.Duration
+	halfLife       time.Duration
+	prior          float64
+}
+
+// score weighs the plays within the window by their age, halving every
+// half-life, and divides them by the plays the game usually gets within a
+// window. The baseline is taken from the rest of the baseline window, so a
+// game that is always busy does not trend for being busy. The prior keeps
+// games with little history from trending on a handful of plays.
+func (s scoring) score(counts []domain.PlayCount, now time.Time) []domain.Score {
+	type tally struct {
+		velocity float64
+		plays    int
+		baseline int
+	}
+
+	windowStart := now.Add(-s.window)
+	baselineStart := now.Add(-s.baselineWindow)
+
+	tallies := make(map[int]*tally)
+
+	for _, c := range counts {
+		if c.Bucket.Before(baselineStart) {
+			continue
+		}
+
+		t, ok := tallies[c.GameID]
+		if !ok {
+			t = &tally{}
+			tallies[c.GameID] = t
+		}
+
+		if !c.Bucket.After(windowStart) {
+			t.baseline += c.Plays
+			continue
+		}
+
+		age := now.Sub(c.Bucket)
+		if age < 0 {
+			age = 0
+		}
+
+		t.velocity += float64(c.Plays) * math.Exp2(-age.Hours()/s.halfLife.Hours())
+		t.plays += c.Plays
+	}
+
+	perWindow := s.window.Hours() / (s.baselineWindow - s.window).Hours()
+
+	res := make([]domain.Score, 0, len(tallies))
+
+	for id, t := range tallies {
+		if t.plays == 0 {
+			continue
+		}
+
+		res = append(res, domain.Score{
+			GameID: id,
+			Score:  t.velocity / (float64(t.baseline)*perWindow + s.prior),
+			Plays:  t.plays,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+
+		return res[i].GameID < res[j].GameID
+	})
+
+	return res
+}
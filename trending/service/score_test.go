@@ -0,0 +1,84 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vediagames/platform/trending/domain"
+)
+
+func TestScoring_Score(t *testing.T) {
+	now := time.Date(2023, 10, 25, 12, 0, 0, 0, time.UTC)
+
+	s := scoring{
+		window:         24 * time.Hour,
+		baselineWindow: 8 * 24 * time.Hour,
+		halfLife:       6 * time.Hour,
+		prior:          5,
+	}
+
+	// counts spreads perDay plays over every hour between from and to ago.
+	counts := func(id int, from, to time.Duration, perDay int) []domain.PlayCount {
+		var res []domain.PlayCount
+
+		for at := from; at < to; at += time.Hour {
+			res = append(res, domain.PlayCount{
+				GameID: id,
+				Bucket: now.Add(-at),
+				Plays:  perDay / 24,
+			})
+		}
+
+		return res
+	}
+
+	var data []domain.PlayCount
+	// 1: always busy.
+	data = append(data, counts(1, 0, 8*24*time.Hour, 2400)...)
+	// 2: usually quiet, busy today.
+	data = append(data, counts(2, 0, 24*time.Hour, 960)...)
+	data = append(data, counts(2, 24*time.Hour, 8*24*time.Hour, 48)...)
+	// 3: was busy, nothing today.
+	data = append(data, counts(3, 24*time.Hour, 8*24*time.Hour, 2400)...)
+	// 4: busy beyond the baseline window only.
+	data = append(data, counts(4, 8*24*time.Hour, 10*24*time.Hour, 2400)...)
+
+	scores := s.score(data, now)
+
+	if len(scores) != 2 {
+		t.Fatalf("scored %d games, want 2: %+v", len(scores), scores)
+	}
+
+	if scores[0].GameID != 2 || scores[1].GameID != 1 {
+		t.Errorf("order = %d, %d, want 2, 1", scores[0].GameID, scores[1].GameID)
+	}
+
+	if scores[0].Plays != 960 {
+		t.Errorf("plays = %d, want 960", scores[0].Plays)
+	}
+}
+
+func TestScoring_ScoreDecay(t *testing.T) {
+	now := time.Date(2023, 10, 25, 12, 0, 0, 0, time.UTC)
+
+	s := scoring{
+		window:         24 * time.Hour,
+		baselineWindow: 7 * 24 * time.Hour,
+		halfLife:       6 * time.Hour,
+		prior:          1,
+	}
+
+	scores := s.score([]domain.PlayCount{
+		{GameID: 1, Bucket: now.Add(-19 * time.Hour), Plays: 100},
+		{GameID: 2, Bucket: now.Add(-time.Hour), Plays: 100},
+	}, now)
+
+	if len(scores) != 2 || scores[0].GameID != 2 {
+		t.Fatalf("scores = %+v, want game 2 first", scores)
+	}
+
+	// Three half-lives apart.
+	if ratio := scores[0].Score / scores[1].Score; ratio < 7.99 || ratio > 8.01 {
+		t.Errorf("ratio = %f, want 8", ratio)
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
+
+	"github.com/vediagames/platform/trending/domain"
+)
+
+type Config struct {
+	Repository domain.Repository
+	// Interval is the time between two computations of the snapshot.
+	Interval time.Duration
+	// Window is the recent time whose plays make a game trend.
+	Window time.Duration
+	// BaselineWindow is the time the baseline of a game is taken from, it
+	// includes the window.
+	BaselineWindow time.Duration
+	// HalfLife is the age at which a play counts half.
+	HalfLife time.Duration
+	// Prior is added to the baseline of every game, in plays per window.
+	Prior float64
+}
+
+func (c Config) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Repository == nil, fmt.Errorf("empty repository"))
+	err.AddIf(c.Interval <= 0, fmt.Errorf("interval must be positive"))
+	err.AddIf(c.Window <= 0, fmt.Errorf("window must be positive"))
+	err.AddIf(c.BaselineWindow <= c.Window, fmt.Errorf("baseline window must be longer than window"))
+	err.AddIf(c.HalfLife <= 0, fmt.Errorf("half-life must be positive"))
+	err.AddIf(c.Prior <= 0, fmt.Errorf("prior must be positive"))
+
+	return err.Err()
+}
+
+// Service computes the trending snapshot from play events. It implements
+// domain.Service and recomputes the snapshot periodically through Run.
+type Service struct {
+	repository     domain.Repository
+	interval       time.Duration
+	baselineWindow time.Duration
+	scoring        scoring
+}
+
+var _ domain.Service = &Service{}
+
+func New(cfg Config) *Service {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	return &Service{
+		repository:     cfg.Repository,
+		interval:       cfg.Interval,
+		baselineWindow: cfg.BaselineWindow,
+		scoring: scoring{
+			window:         cfg.Window,
+			baselineWindow: cfg.BaselineWindow,
+			halfLife:       cfg.HalfLife,
+			prior:          cfg.Prior,
+		},
+	}
+}
+
+// Run computes the snapshot every interval until ctx is done.
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			res, err := s.Compute(ctx)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to compute trending games")
+				continue
+			}
+
+			zerolog.Ctx(ctx).Info().
+				Int("scored", res.Scored).
+				Msg("computed trending games")
+		}
+	}
+}
+
+func (s *Service) Compute(ctx context.Context) (domain.ComputeResponse, error) {
+	now := time.Now()
+
+	countsRes, err := s.repository.FindPlayCounts(ctx, domain.FindPlayCountsQuery{
+		Since: now.Add(-s.baselineWindow),
+	})
+	if err != nil {
+		return domain.ComputeResponse{}, fmt.Errorf("failed to find play counts: %w", err)
+	}
+
+	scores := s.scoring.score(countsRes.Data, now)
+
+	for i, sc := range scores {
+		if err := sc.Validate(); err != nil {
+			return domain.ComputeResponse{}, fmt.Errorf("%w at index %d: %w", domain.ErrInvalidData, i, err)
+		}
+	}
+
+	if err := s.repository.ReplaceScores(ctx, domain.ReplaceScoresQuery{
+		Data:       scores,
+		ComputedAt: now,
+	}); err != nil {
+		return domain.ComputeResponse{}, fmt.Errorf("failed to replace scores: %w", err)
+	}
+
+	return domain.ComputeResponse{
+		Scored: len(scores),
+	}, nil
+}
@@ -2,52 +2,136 @@ package webproxy
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/go-redis/redis/v8"
 	"github.com/rs/zerolog"
+	"github.com/vediagames/zeroerror"
 )
 
+type CacheConfig struct {
+	RedisAddress string
+	Prefix       string
+	TTL          time.Duration
+	// LRUSize is the number of entries kept in process, in front of Redis
+	// or instead of it when Redis is unavailable.
+	LRUSize int
+}
+
+func (c CacheConfig) Validate() error {
+	var err zeroerror.Error
+
+	err.AddIf(c.Prefix == "", fmt.Errorf("empty prefix"))
+	err.AddIf(c.TTL <= 0, fmt.Errorf("TTL must be positive"))
+	err.AddIf(c.LRUSize < 1, fmt.Errorf("LRU size must be positive"))
+
+	return err.Err()
+}
+
+// Cache is a graphql.Cache for automatic persisted queries. Entries map a
+// query hash to the query itself and never change, so the in-process LRU is
+// always checked first and Redis only shares entries between instances.
+//
+// When Redis fails the cache falls back to the LRU and tries Redis again
+// after retryAfter, so an outage costs one failed call per retryAfter rather
+// than one per request.
 type Cache struct {
 	client    redis.UniversalClient
+	local     *lru.LRU
 	ttl       time.Duration
 	apqPrefix string
+	// skipUntil is the Unix time in nanoseconds until which Redis is not
+	// called.
+	skipUntil *atomic.Int64
+	now       func() time.Time
 }
 
-func NewCache(ctx context.Context, redisAddress string, ttl time.Duration) Cache {
-	client := redis.NewClient(&redis.Options{
-		Addr: redisAddress,
-	})
+const retryAfter = 5 * time.Second
 
-	err := client.Ping(ctx).Err()
-	if err != nil {
-		panic(fmt.Errorf("failed to ping: %w", err))
+var _ graphql.Cache = Cache{}
+
+func NewCache(ctx context.Context, cfg CacheConfig) Cache {
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
+	cache := Cache{
+		local:     lru.New(cfg.LRUSize),
+		ttl:       cfg.TTL,
+		apqPrefix: cfg.Prefix,
+		skipUntil: &atomic.Int64{},
+		now:       time.Now,
+	}
+
+	if cfg.RedisAddress == "" {
+		return cache
 	}
 
-	return Cache{
-		client: client,
-		ttl:    ttl,
+	cache.client = redis.NewClient(&redis.Options{
+		Addr: cfg.RedisAddress,
+	})
+
+	if err := cache.client.Ping(ctx).Err(); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(fmt.Errorf("failed to ping: %w", err)).
+			Msg("redis is unavailable, caching persisted queries in process until it is back")
+
+		cache.fail()
 	}
+
+	return cache
+}
+
+// useRedis reports whether Redis is configured and did not fail within the
+// last retryAfter.
+func (c Cache) useRedis() bool {
+	return c.client != nil && c.now().UnixNano() >= c.skipUntil.Load()
+}
+
+func (c Cache) fail() {
+	c.skipUntil.Store(c.now().Add(retryAfter).UnixNano())
 }
 
 func (c Cache) Add(ctx context.Context, key string, value interface{}) {
+	c.local.Add(ctx, key, value)
+
+	if !c.useRedis() {
+		return
+	}
+
 	key = fmt.Sprintf("%s:%s", c.apqPrefix, key)
 
 	_, err := c.client.Set(ctx, key, value, c.ttl).Result()
 	if err != nil {
-		zerolog.Ctx(ctx).Err(fmt.Errorf("failed to set cache for key %q: %w", key, err))
+		zerolog.Ctx(ctx).Error().Err(fmt.Errorf("failed to set cache for key %q: %w", key, err)).Send()
+		c.fail()
 	}
 }
 
 func (c Cache) Get(ctx context.Context, key string) (interface{}, bool) {
-	key = fmt.Sprintf("%s:%s", c.apqPrefix, key)
+	if v, ok := c.local.Get(ctx, key); ok {
+		return v, true
+	}
 
-	s, err := c.client.Get(ctx, key).Result()
-	if err != nil {
-		zerolog.Ctx(ctx).Err(fmt.Errorf("failed to get cache for key %q: %w", key, err))
+	if !c.useRedis() {
+		return nil, false
+	}
+
+	s, err := c.client.Get(ctx, fmt.Sprintf("%s:%s", c.apqPrefix, key)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil, false
+	case err != nil:
+		zerolog.Ctx(ctx).Error().Err(fmt.Errorf("failed to get cache for key %q: %w", key, err)).Send()
+		c.fail()
 		return nil, false
 	}
 
+	c.local.Add(ctx, key, s)
+
 	return s, true
 }
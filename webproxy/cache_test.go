@@ -0,0 +1,70 @@
+package webproxy
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCache_FallsBackToLRU(t *testing.T) {
+	ctx := context.Background()
+
+	cache := NewCache(ctx, CacheConfig{
+		// Nothing listens on port 1, so Redis fails on every call.
+		RedisAddress: "127.0.0.1:1",
+		Prefix:       "apq",
+		TTL:          time.Minute,
+		LRUSize:      1,
+	})
+
+	if _, ok := cache.Get(ctx, "a"); ok {
+		t.Fatalf("Get() on empty cache returned a hit")
+	}
+
+	cache.Add(ctx, "a", "query { a }")
+
+	if v, ok := cache.Get(ctx, "a"); !ok || v != "query { a }" {
+		t.Errorf("Get() = %v, %v, want query, true", v, ok)
+	}
+
+	cache.Add(ctx, "b", "query { b }")
+
+	if _, ok := cache.Get(ctx, "a"); ok {
+		t.Errorf("Get() returned an evicted entry")
+	}
+}
+
+func TestCache_RetriesRedis(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	cache := NewCache(ctx, CacheConfig{
+		RedisAddress: "127.0.0.1:1",
+		Prefix:       "apq",
+		TTL:          time.Minute,
+		LRUSize:      1,
+	})
+	cache.now = func() time.Time { return now }
+	cache.fail()
+
+	if cache.client == nil {
+		t.Fatalf("client was dropped after the failed ping")
+	}
+
+	if cache.useRedis() {
+		t.Errorf("useRedis() right after a failure = true, want false")
+	}
+
+	now = now.Add(retryAfter)
+
+	if !cache.useRedis() {
+		t.Errorf("useRedis() after %s = false, want true", retryAfter)
+	}
+
+	// The failed call skips Redis for another retryAfter.
+	cache.Get(ctx, "a")
+
+	if cache.useRedis() {
+		t.Errorf("useRedis() after a failed call = true, want false")
+	}
+}
@@ -1602,6 +1602,7 @@ enum Status {
     invisible
     published
     deleted
+    scheduled
 }
 
 enum Language {
@@ -1622,6 +1623,7 @@ enum SortingMethod {
     most_disliked
     least_disliked
     most_relevant
+    trending
 }
 
 enum SearchItemType {
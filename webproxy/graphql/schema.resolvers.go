@@ -296,13 +296,6 @@ func (r *queryResolver) HomePage(ctx context.Context, request model.HomePageRequ
 
 // GamePage is the resolver for the gamePage field.
 func (r *queryResolver) GamePage(ctx context.Context, request model.GamePageRequest) (*model.GamePageResponse, error) {
-	var tagsToIgnore = map[int]string{
-		12: "boys",
-		13: "girls",
-		21: "challenge",
-		54: "brain",
-	}
-
 	gameRes, err := r.gatewayResolver.Query().Game(ctx, model1.GameRequest{
 		Field:    model1.GetByFieldSlug,
 		Value:    request.Slug,
@@ -312,36 +305,62 @@ func (r *queryResolver) GamePage(ctx context.Context, request model.GamePageRequ
 		return nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
-	tagIDs := make([]int, 0, len(gameRes.Game.TagIDRefs))
-	for _, tag := range gameRes.Game.TagIDRefs {
-		if _, ok := tagsToIgnore[tag]; !ok {
-			tagIDs = append(tagIDs, tag)
-		}
+	otherGamesRes, err := r.gatewayResolver.Query().SimilarGames(ctx, gameRes.Game.ID, 8, request.Language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get similar games: %w", err)
 	}
 
-	otherGamesRes, err := r.gatewayResolver.Query().Games(ctx, model1.GamesRequest{
-		Language:        request.Language,
-		Page:            1,
-		Limit:           8,
-		Sort:            sortingMethodToPointer(model1.SortingMethodRandom),
-		Categories:      gameRes.Game.CategoryIDRefs,
-		Tags:            tagIDs,
-		ExcludedGameIDs: []int{gameRes.Game.ID},
-	})
+	reaction, err := r.gatewayResolver.Query().GameReaction(ctx, gameRes.Game.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list games: %w", err)
+		return nil, fmt.Errorf("failed to get reaction: %w", err)
+	}
+
+	// Clients without a session or user still keep their reactions locally.
+	if reaction == model1.GameReactionNone {
+		switch {
+		case containsID(request.LikedGameIDs, gameRes.Game.ID):
+			reaction = model1.GameReactionLike
+		case containsID(request.DislikedGameIDs, gameRes.Game.ID):
+			reaction = model1.GameReactionDislike
+		}
 	}
 
 	return &model.GamePageResponse{
 		Game:       gameRes.Game,
 		OtherGames: otherGamesRes.Games,
-		IsLiked:    false,
-		IsDisliked: false,
+		IsLiked:    reaction == model1.GameReactionLike,
+		IsDisliked: reaction == model1.GameReactionDislike,
 	}, nil
 }
 
 // ContinuePlayingPage is the resolver for the continuePlayingPage field.
 func (r *queryResolver) ContinuePlayingPage(ctx context.Context, request model.ContinuePlayingPageRequest) (*model.ContinuePlayingPageResponse, error) {
+	// Without a local history the client gets the stored one, which is
+	// already ordered by last played.
+	if len(request.LastPlayedGameIDs) == 0 {
+		playedRes, err := r.gatewayResolver.Query().RecentlyPlayedGames(ctx, model1.RecentlyPlayedGamesRequest{
+			Language: request.Language,
+			Page:     request.Page,
+			Limit:    15,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recently played games: %w", err)
+		}
+
+		games := &model1.Games{
+			Data:  make([]*model1.Game, 0, len(playedRes.Games)),
+			Total: playedRes.Total,
+		}
+
+		for _, played := range playedRes.Games {
+			games.Data = append(games.Data, played.Game)
+		}
+
+		return &model.ContinuePlayingPageResponse{
+			Games: games,
+		}, nil
+	}
+
 	gameRes, err := r.gatewayResolver.Query().Games(ctx, model1.GamesRequest{
 		Language: request.Language,
 		Page:     request.Page,
@@ -358,8 +377,15 @@ func (r *queryResolver) ContinuePlayingPage(ctx context.Context, request model.C
 		Total: gameRes.Games.Total,
 	}
 
+	gamesByID := make(map[int]*model1.Game, len(gameRes.Games.Data))
 	for _, game := range gameRes.Games.Data {
-		orderedGames.Data = append(orderedGames.Data, game)
+		gamesByID[game.ID] = game
+	}
+
+	for _, id := range request.LastPlayedGameIDs {
+		if game, ok := gamesByID[id]; ok {
+			orderedGames.Data = append(orderedGames.Data, game)
+		}
 	}
 
 	return &model.ContinuePlayingPageResponse{
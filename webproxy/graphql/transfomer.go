@@ -8,3 +8,13 @@ func sortingMethodToPointer(m model.SortingMethod) *model.SortingMethod {
 	c := m
 	return &c
 }
+
+func containsID(ids []*int, id int) bool {
+	for _, i := range ids {
+		if i != nil && *i == id {
+			return true
+		}
+	}
+
+	return false
+}